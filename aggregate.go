@@ -0,0 +1,230 @@
+package goprices
+
+import (
+	"strings"
+
+	"github.com/site-name/decimal"
+)
+
+// Aggregatable is a constraint for types supported by aggregate functions
+type Aggregatable interface {
+	Money | TaxedMoney
+}
+
+// Seq is an iterator over values of type T, compatible with iter.Seq from go 1.23
+type Seq[T any] func(yield func(T) bool)
+
+// seqOf returns an iterator over given values
+func seqOf[T any](values []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Sum adds up given values. All of them must have given currency.
+// Zero in given currency is returned if values is empty.
+//
+// Returned error could be `nil`, an *UnknownCurrencyError or a *CurrencyMismatchError
+//
+// E.g:
+//
+//	Sum(USD, []Money{...})
+//	Sum(USD, []TaxedMoney{...})
+func Sum[T Aggregatable](currency string, values []T) (*T, error) {
+	return SumSeq(currency, seqOf(values))
+}
+
+// SumSeq is like Sum but takes an iterator.
+func SumSeq[T Aggregatable](currency string, values Seq[T]) (*T, error) {
+	return ptr(aggregate(currency, values, nil))
+}
+
+// Average returns the arithmetic mean of given values. All of them must have given currency.
+// Zero in given currency is returned if values is empty.
+//
+// NOTE: the result is rounded half up to currency precision, like DivDecimal does.
+//
+// Returned error could be `nil`, an *UnknownCurrencyError or a *CurrencyMismatchError
+func Average[T Aggregatable](currency string, values []T) (*T, error) {
+	return AverageSeq(currency, seqOf(values))
+}
+
+// AverageSeq is like Average but takes an iterator.
+func AverageSeq[T Aggregatable](currency string, values Seq[T]) (*T, error) {
+	count := int64(0)
+	sum, err := aggregate(currency, values, func(T) { count++ })
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &sum, nil
+	}
+
+	var res any
+	switch v := any(sum).(type) {
+	case Money:
+		res, err = v.divDecimal(decimal.NewFromInt(count))
+	case TaxedMoney:
+		res, err = v.divDecimal(decimal.NewFromInt(count))
+	}
+	return ptr(res.(T), err)
+}
+
+// MinMax returns the smallest and largest of given values. All of them must have given currency.
+// TaxedMoney values are compared by gross, like TaxedMoney.LessThan does.
+// Zero in given currency is returned for both if values is empty.
+//
+// Returned error could be `nil`, an *UnknownCurrencyError or a *CurrencyMismatchError
+func MinMax[T Aggregatable](currency string, values []T) (min *T, max *T, err error) {
+	return MinMaxSeq(currency, seqOf(values))
+}
+
+// MinMaxSeq is like MinMax but takes an iterator.
+func MinMaxSeq[T Aggregatable](currency string, values Seq[T]) (min *T, max *T, err error) {
+	lo, hi, err := minMax(currency, values)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &lo, &hi, nil
+}
+
+// RangeOf returns the smallest money range containing all given prices.
+// A degenerate range of zero in given currency is returned if prices is empty.
+//
+// Returned error could be `nil`, an *UnknownCurrencyError or a *CurrencyMismatchError
+func RangeOf(currency string, prices []Money) (*MoneyRange, error) {
+	return RangeOfSeq(currency, seqOf(prices))
+}
+
+// RangeOfSeq is like RangeOf but takes an iterator.
+func RangeOfSeq(currency string, prices Seq[Money]) (*MoneyRange, error) {
+	start, stop, err := minMax(currency, prices)
+	if err != nil {
+		return nil, err
+	}
+	return &MoneyRange{start: start, stop: stop}, nil
+}
+
+// TaxedRangeOf returns the smallest taxed money range containing all given prices, comparing them by gross.
+// A degenerate range of zero in given currency is returned if prices is empty.
+//
+// Returned error could be `nil`, an *UnknownCurrencyError or a *CurrencyMismatchError
+func TaxedRangeOf(currency string, prices []TaxedMoney) (*TaxedMoneyRange, error) {
+	return TaxedRangeOfSeq(currency, seqOf(prices))
+}
+
+// TaxedRangeOfSeq is like TaxedRangeOf but takes an iterator.
+func TaxedRangeOfSeq(currency string, prices Seq[TaxedMoney]) (*TaxedMoneyRange, error) {
+	start, stop, err := minMax(currency, prices)
+	if err != nil {
+		return nil, err
+	}
+	return &TaxedMoneyRange{start: start, stop: stop}, nil
+}
+
+// zeroOf returns zero value of T in given currency
+func zeroOf[T Aggregatable](currency string) T {
+	zero := Money{decimal.Zero, currency}
+
+	var res any
+	switch any(*new(T)).(type) {
+	case Money:
+		res = zero
+	case TaxedMoney:
+		res = TaxedMoney{zero, zero}
+	}
+	return res.(T)
+}
+
+// iterate validates given currency and calls fn for every value, stopping at the first currency mismatch.
+func iterate[T Aggregatable](currency string, values Seq[T], fn func(T)) (string, error) {
+	unit, err := validateCurrency(currency)
+	if err != nil {
+		return "", err
+	}
+
+	values(func(value T) bool {
+		var valueCurrency string
+		switch v := any(value).(type) {
+		case Money:
+			valueCurrency = v.currency
+		case TaxedMoney:
+			valueCurrency = v.GetCurrency()
+		}
+		if !strings.EqualFold(valueCurrency, unit) {
+			err = &CurrencyMismatchError{Left: unit, Right: valueCurrency}
+			return false
+		}
+		fn(value)
+		return true
+	})
+	return unit, err
+}
+
+// aggregate sums up given values, calling each (if not nil) for every value.
+func aggregate[T Aggregatable](currency string, values Seq[T], each func(T)) (T, error) {
+	net, gross := decimal.Zero, decimal.Zero
+	unit, err := iterate(currency, values, func(value T) {
+		switch v := any(value).(type) {
+		case Money:
+			net = net.Add(v.amount)
+		case TaxedMoney:
+			net = net.Add(v.net.amount)
+			gross = gross.Add(v.gross.amount)
+		}
+		if each != nil {
+			each(value)
+		}
+	})
+	if err != nil {
+		return *new(T), err
+	}
+
+	var res any
+	switch any(*new(T)).(type) {
+	case Money:
+		res = Money{net, unit}
+	case TaxedMoney:
+		res = TaxedMoney{Money{net, unit}, Money{gross, unit}}
+	}
+	return res.(T), nil
+}
+
+func minMax[T Aggregatable](currency string, values Seq[T]) (T, T, error) {
+	var lo, hi T
+	found := false
+	unit, err := iterate(currency, values, func(value T) {
+		if !found {
+			lo, hi, found = value, value, true
+			return
+		}
+		if lessThan(value, lo) {
+			lo = value
+		}
+		if lessThan(hi, value) {
+			hi = value
+		}
+	})
+	if err != nil {
+		return lo, hi, err
+	}
+	if !found {
+		zero := zeroOf[T](unit)
+		return zero, zero, nil
+	}
+	return lo, hi, nil
+}
+
+func lessThan[T Aggregatable](a, b T) bool {
+	switch v := any(a).(type) {
+	case Money:
+		return v.LessThan(any(b).(Money))
+	case TaxedMoney:
+		return v.LessThan(any(b).(TaxedMoney))
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestSum(t *testing.T) {
+	prices := []Money{
+		*must(NewMoney(1.5, USD)),
+		*must(NewMoney(2.25, USD)),
+		*must(NewMoney(3, USD)),
+	}
+
+	sum, err := Sum(USD, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sum.Equal(*must(NewMoney(6.75, USD))) {
+		t.Fatalf("expected 6.75 USD, got: %s", sum)
+	}
+
+	empty, err := Sum[Money]("eur", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !empty.IsZero() || empty.currency != EUR {
+		t.Fatalf("expected 0 EUR, got: %s", empty)
+	}
+
+	_, err = Sum(USD, append(prices, *must(NewMoney(1, EUR))))
+	var mismatch *CurrencyMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Left != USD || mismatch.Right != EUR {
+		t.Fatalf("expected currency mismatch error, got: %v", err)
+	}
+
+	if _, err := Sum[Money]("abc", nil); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestSumSeqStopsAtMismatch(t *testing.T) {
+	yielded := 0
+	seq := func(yield func(Money) bool) {
+		for _, currency := range []string{USD, EUR, USD} {
+			yielded++
+			if !yield(*must(NewMoney(1, currency))) {
+				return
+			}
+		}
+	}
+	if _, err := SumSeq(USD, seq); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+	if yielded != 2 {
+		t.Fatalf("expected iteration to stop at the mismatch, yielded: %d", yielded)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	prices := []TaxedMoney{
+		*must(NewTaxedMoneyFromFloats(10, 12, USD)),
+		*must(NewTaxedMoneyFromFloats(20, 24, USD)),
+		*must(NewTaxedMoneyFromFloats(30, 37, USD)),
+	}
+
+	avg, err := Average(USD, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !avg.net.amount.Equal(decimal.NewFromInt(20)) {
+		t.Fatalf("expected net 20, got: %s", avg)
+	}
+	if !avg.gross.amount.Equal(decimal.NewFromFloat(24.33)) {
+		t.Fatalf("expected gross 24.33, got: %s", avg)
+	}
+
+	empty, err := Average[TaxedMoney](USD, nil)
+	if err != nil || !empty.IsZero() {
+		t.Fatalf("expected zero, got: %v, %v", empty, err)
+	}
+}
+
+func TestMinMaxAndRangeOf(t *testing.T) {
+	prices := []Money{
+		*must(NewMoney(5, USD)),
+		*must(NewMoney(1, USD)),
+		*must(NewMoney(9, USD)),
+	}
+
+	lo, hi, err := MinMax(USD, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lo.Equal(prices[1]) || !hi.Equal(prices[2]) {
+		t.Fatalf("expected 1 and 9, got: %s and %s", lo, hi)
+	}
+
+	moneyRange, err := RangeOf(USD, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !moneyRange.Equal(*must(NewMoneyRangeFromFloats(1, 9, USD))) {
+		t.Fatalf("expected 1-9 USD, got: %s", moneyRange)
+	}
+
+	empty, err := RangeOf(USD, nil)
+	if err != nil || !empty.IsZero() || !empty.IsDegenerate() {
+		t.Fatalf("expected zero range, got: %v, %v", empty, err)
+	}
+
+	taxedRange, err := TaxedRangeOf(USD, []TaxedMoney{
+		*must(NewTaxedMoneyFromFloats(10, 13, USD)),
+		*must(NewTaxedMoneyFromFloats(11, 12, USD)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !taxedRange.start.gross.amount.Equal(decimal.NewFromInt(12)) {
+		t.Fatalf("expected range starting at gross 12, got: %s", taxedRange)
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	prices := make([]Money, 100)
+	for i := range prices {
+		prices[i] = *must(NewMoney(float64(i), USD))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Sum(USD, prices); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
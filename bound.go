@@ -0,0 +1,137 @@
+package goprices
+
+import "fmt"
+
+// Bound tells how an end of a range limits it.
+// The zero value is Inclusive, so ranges are closed unless told otherwise.
+type Bound uint8
+
+const (
+	Inclusive Bound = iota // the end value belongs to the range
+	Exclusive              // the end value does not belong to the range
+	Unbounded              // the range has no limit on this side, the end value is ignored
+)
+
+func (b Bound) valid() bool {
+	return b <= Unbounded
+}
+
+// startBracket returns interval notation of a start bound, e.g: "[" for Inclusive
+func (b Bound) startBracket() string {
+	if b == Inclusive {
+		return "["
+	}
+	return "("
+}
+
+// stopBracket returns interval notation of a stop bound, e.g: "]" for Inclusive
+func (b Bound) stopBracket() string {
+	if b == Inclusive {
+		return "]"
+	}
+	return ")"
+}
+
+// combineBounds returns bound of an end computed from ends with given bounds, e.g: sum of two ranges' starts
+func combineBounds(a, b Bound) Bound {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// rangeEnd is an end of a range of T, which is either Money or TaxedMoney
+type rangeEnd[T any] struct {
+	value T
+	bound Bound
+}
+
+// compareStarts orders starts of ranges: an unbounded start comes first,
+// an exclusive start comes after an inclusive start of the same value.
+func compareStarts[T any](a, b rangeEnd[T], compare func(a, b T) (int, error)) (int, error) {
+	switch {
+	case a.bound == Unbounded && b.bound == Unbounded:
+		return 0, nil
+	case a.bound == Unbounded:
+		return -1, nil
+	case b.bound == Unbounded:
+		return 1, nil
+	}
+	c, err := compare(a.value, b.value)
+	if err != nil || c != 0 {
+		return c, err
+	}
+	return int(a.bound) - int(b.bound), nil
+}
+
+// compareStops orders stops of ranges: an unbounded stop comes last,
+// an exclusive stop comes before an inclusive stop of the same value.
+func compareStops[T any](a, b rangeEnd[T], compare func(a, b T) (int, error)) (int, error) {
+	switch {
+	case a.bound == Unbounded && b.bound == Unbounded:
+		return 0, nil
+	case a.bound == Unbounded:
+		return 1, nil
+	case b.bound == Unbounded:
+		return -1, nil
+	}
+	c, err := compare(a.value, b.value)
+	if err != nil || c != 0 {
+		return c, err
+	}
+	return int(b.bound) - int(a.bound), nil
+}
+
+// isEmpty checks if no value lies between given start and stop
+func isEmpty[T any](start, stop rangeEnd[T], compare func(a, b T) (int, error)) (bool, error) {
+	if start.bound == Unbounded || stop.bound == Unbounded {
+		return false, nil
+	}
+	c, err := compare(start.value, stop.value)
+	if err != nil {
+		return false, err
+	}
+	return c > 0 || (c == 0 && (start.bound == Exclusive || stop.bound == Exclusive)), nil
+}
+
+// hasGap checks if some value lies between a range ending at stop and a range beginning at start, e.g:
+// there is a gap between [1, 2) and (2, 3], but not between [1, 2) and [2, 3]
+func hasGap[T any](stop, start rangeEnd[T], compare func(a, b T) (int, error)) (bool, error) {
+	if start.bound == Unbounded || stop.bound == Unbounded {
+		return false, nil
+	}
+	c, err := compare(stop.value, start.value)
+	if err != nil {
+		return false, err
+	}
+	return c < 0 || (c == 0 && stop.bound == Exclusive && start.bound == Exclusive), nil
+}
+
+// contains checks if value lies between given start and stop
+func contains[T any](start, stop rangeEnd[T], value T, compare func(a, b T) (int, error)) bool {
+	if start.bound != Unbounded {
+		c, err := compare(start.value, value)
+		if err != nil || c > 0 || (c == 0 && start.bound == Exclusive) {
+			return false
+		}
+	}
+	if stop.bound != Unbounded {
+		c, err := compare(value, stop.value)
+		if err != nil || c > 0 || (c == 0 && stop.bound == Exclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// formatRange returns interval notation of a range, e.g: "[Money{20, USD}, +inf)"
+func formatRange[T fmt.Stringer](start, stop rangeEnd[T]) string {
+	startString, stopString := "-inf", "+inf"
+	if start.bound != Unbounded {
+		startString = start.value.String()
+	}
+	if stop.bound != Unbounded {
+		stopString = stop.value.String()
+	}
+	return start.bound.startBracket() + startString + ", " + stopString + stop.bound.stopBracket()
+}
@@ -0,0 +1,188 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoneyRangeBounds(t *testing.T) {
+	twenty := *must(NewMoney(20, USD))
+	hundred := *must(NewMoney(100, USD))
+
+	from := *must(NewMoneyRangeFrom(twenty, Inclusive))
+	under := *must(NewMoneyRangeTo(hundred, Exclusive))
+	halfOpen := *must(NewMoneyRangeWithBounds(twenty, Inclusive, hundred, Exclusive))
+
+	type testUnit struct {
+		value    float64
+		from     bool
+		under    bool
+		halfOpen bool
+	}
+	for index, unit := range []testUnit{
+		{10, false, true, false},
+		{20, true, true, true},
+		{99.99, true, true, true},
+		{100, true, false, false},
+		{1000, true, false, false},
+	} {
+		value := *must(NewMoney(unit.value, USD))
+		if from.Contains(value) != unit.from || under.Contains(value) != unit.under || halfOpen.Contains(value) != unit.halfOpen {
+			t.Fatalf("Error at index: %d, unexpected Contains result for %s", index, value)
+		}
+	}
+
+	if from.String() != "MoneyRange[Money{20, USD}, +inf)" {
+		t.Fatalf("unexpected string: %s", from)
+	}
+	if under.String() != "MoneyRange(-inf, Money{100, USD})" {
+		t.Fatalf("unexpected string: %s", under)
+	}
+	if !under.IsNegative() || from.IsNegative() || !from.IsPositive() || from.IsBounded() {
+		t.Fatal("unexpected sign helpers result")
+	}
+	if _, err := from.Width(); !errors.Is(err, ErrRangeUnbounded) {
+		t.Fatalf("expected ErrRangeUnbounded, got: %v", err)
+	}
+
+	if _, err := NewMoneyRangeWithBounds(twenty, Inclusive, twenty, Exclusive); !errors.Is(err, ErrStopLessThanStart) {
+		t.Fatalf("expected empty range to be rejected, got: %v", err)
+	}
+	if _, err := NewMoneyRangeWithBounds(twenty, Bound(10), hundred, Inclusive); !errors.Is(err, ErrInvalidBound) {
+		t.Fatalf("expected ErrInvalidBound, got: %v", err)
+	}
+	if _, err := NewMoneyRangeTo(Money{}, Exclusive); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestMoneyRangeBoundsArithmetic(t *testing.T) {
+	five := *must(NewMoney(5, USD))
+	from := *must(NewMoneyRangeFrom(*must(NewMoney(20, USD)), Exclusive))
+
+	shifted := from.MustAdd(five)
+	if shifted.String() != "MoneyRange(Money{25, USD}, +inf)" {
+		t.Fatalf("unexpected result: %s", shifted)
+	}
+	if doubled := from.Mul(2); doubled.String() != "MoneyRange(Money{40, USD}, +inf)" {
+		t.Fatalf("unexpected result: %s", doubled)
+	}
+
+	closed := *must(NewMoneyRangeFromFloats(1, 2, USD))
+	sum := closed.MustAdd(*must(NewMoneyRangeWithBounds(five, Inclusive, five, Inclusive)))
+	if !sum.Equal(*must(NewMoneyRangeFromFloats(6, 7, USD))) {
+		t.Fatalf("unexpected result: %s", sum)
+	}
+	if sum := closed.MustAdd(from); sum.StartBound() != Exclusive || sum.StopBound() != Unbounded {
+		t.Fatalf("unexpected bounds: %s", sum)
+	}
+
+	discounted := must(FixedDiscount[MoneyRange](from, five))
+	if discounted.StartBound() != Exclusive || discounted.StopBound() != Unbounded {
+		t.Fatalf("expected bounds to be kept by discounts, got: %s", discounted)
+	}
+
+	signed := *must(NewSignedMoneyRange(*must(NewSignedMoney(-5, USD)), *must(NewSignedMoney(-3, USD))))
+	abs := must(signed.WithBounds(Unbounded, Exclusive)).Abs()
+	if abs.String() != "MoneyRange(Money{3, USD}, +inf)" {
+		t.Fatalf("unexpected result: %s", abs)
+	}
+}
+
+func TestMoneyRangeBoundsAlgebra(t *testing.T) {
+	newRange := func(start float64, startBound Bound, stop float64, stopBound Bound) MoneyRange {
+		return *must(NewMoneyRangeWithBounds(*must(NewMoney(start, USD)), startBound, *must(NewMoney(stop, USD)), stopBound))
+	}
+
+	a := newRange(1, Inclusive, 2, Exclusive)
+	b := newRange(2, Inclusive, 3, Inclusive)
+	if a.Overlaps(b) {
+		t.Fatal("[1, 2) and [2, 3] must not overlap")
+	}
+	if union := must(a.Union(b)); !union.Equal(newRange(1, Inclusive, 3, Inclusive)) {
+		t.Fatalf("unexpected union: %s", union)
+	}
+	if _, err := a.Union(newRange(2, Exclusive, 3, Inclusive)); !errors.Is(err, ErrRangesDisjoint) {
+		t.Fatalf("expected ErrRangesDisjoint, got: %v", err)
+	}
+
+	from := *must(NewMoneyRangeFrom(*must(NewMoney(2.5, USD)), Exclusive))
+	if intersection := must(b.Intersect(from)); !intersection.Equal(newRange(2.5, Exclusive, 3, Inclusive)) {
+		t.Fatalf("unexpected intersection: %s", intersection)
+	}
+	if span := must(a.Span(from)); span.String() != "MoneyRange[Money{1, USD}, +inf)" {
+		t.Fatalf("unexpected span: %s", span)
+	}
+	if !from.ContainsRange(newRange(3, Inclusive, 4, Exclusive)) || from.ContainsRange(b) {
+		t.Fatal("unexpected ContainsRange result")
+	}
+
+	eur := *must(NewMoneyRangeFrom(*must(NewMoney(1, EUR)), Inclusive))
+	if _, err := from.Intersect(eur); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
+
+func TestTaxedMoneyRangeBounds(t *testing.T) {
+	start := *must(NewTaxedMoneyFromFloats(10, 12, USD))
+	from := *must(NewTaxedMoneyRangeFrom(start, Exclusive))
+
+	if from.Contains(start) || !from.Contains(*must(NewTaxedMoneyFromFloats(11, 13, USD))) {
+		t.Fatal("unexpected Contains result")
+	}
+	if from.String() != "TaxedMoneyRange(TaxedMoney{net=Money{10, USD}, gross=Money{12, USD}}, +inf)" {
+		t.Fatalf("unexpected string: %s", from)
+	}
+	shifted := from.MustAdd(*must(NewMoney(1, USD)))
+	if shifted.StartBound() != Exclusive || shifted.StopBound() != Unbounded || !shifted.start.net.Equal(*must(NewMoney(11, USD))) {
+		t.Fatalf("unexpected result: %s", shifted)
+	}
+}
+
+func TestMoneyRangeBoundsNegSub(t *testing.T) {
+	newRange := func(start float64, startBound Bound, stop float64, stopBound Bound) MoneyRange {
+		return *must(NewMoneyRangeWithBounds(*must(NewMoney(start, USD)), startBound, *must(NewMoney(stop, USD)), stopBound))
+	}
+	closed := newRange(10, Inclusive, 20, Inclusive)
+	from := newRange(20, Inclusive, 0, Unbounded)
+	under := newRange(0, Unbounded, 5, Exclusive)
+	halfOpen := newRange(1, Inclusive, 2, Exclusive)
+
+	type testUnit struct {
+		result   MoneyRange
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{from.Neg(), "MoneyRange(-inf, Money{-20, USD}]"},
+		{under.Neg(), "MoneyRange(Money{-5, USD}, +inf)"},
+		{halfOpen.Neg(), "MoneyRange(Money{-2, USD}, Money{-1, USD}]"},
+		{closed.Neg(), "MoneyRange[Money{-20, USD}, Money{-10, USD}]"},
+		{closed.MustSub(from), "MoneyRange[Money{-10, USD}, +inf)"},
+		{closed.MustSub(under), "MoneyRange(-inf, Money{15, USD})"},
+		{closed.MustSub(halfOpen), "MoneyRange[Money{9, USD}, Money{18, USD})"},
+		{halfOpen.MustAdd(under), "MoneyRange(-inf, Money{7, USD})"},
+		{halfOpen.MustAdd(from), "MoneyRange[Money{21, USD}, +inf)"},
+		{from.MustAdd(under), "MoneyRange(-inf, +inf)"},
+	} {
+		if unit.result.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, unit.result)
+		}
+	}
+
+	if neg := from.Neg().Neg(); !neg.Equal(from) {
+		t.Fatalf("expected double negation to return the range, got: %s", neg)
+	}
+}
+
+func TestTaxedMoneyRangeBoundsNegSub(t *testing.T) {
+	from := *must(NewTaxedMoneyRangeFrom(*must(NewTaxedMoneyFromFloats(10, 12, USD)), Exclusive))
+	closed := *must(NewTaxedMoneyRange(*must(NewTaxedMoneyFromFloats(20, 24, USD)), *must(NewTaxedMoneyFromFloats(30, 36, USD))))
+
+	if neg := from.Neg(); neg.StartBound() != Unbounded || neg.StopBound() != Exclusive || !neg.stop.gross.Equal(*must(NewSignedMoney(-12, USD))) {
+		t.Fatalf("unexpected result: %s", neg)
+	}
+	diff := closed.MustSub(from)
+	if diff.StartBound() != Exclusive || diff.StopBound() != Unbounded || !diff.start.net.Equal(*must(NewMoney(10, USD))) {
+		t.Fatalf("unexpected result: %s", diff)
+	}
+}
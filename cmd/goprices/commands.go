@@ -0,0 +1,200 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+	"github.com/site-name/go-prices/internal/pricing"
+)
+
+var roundings = map[string]goprices.Rounding{
+	"up":    goprices.Up,
+	"down":  goprices.Down,
+	"ceil":  goprices.Ceil,
+	"floor": goprices.Floor,
+}
+
+// convert converts money to another currency, reading exchange rates from a JSON file. See pricing.ExchangeRates
+func convert(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	ratesFile := flags.String("rates", "", "JSON file of exchange rates, e.g: {\"base\":\"USD\",\"rates\":{\"EUR\":\"0.92\"}}")
+	to := flags.String("to", "", "currency to convert to")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *ratesFile == "" || *to == "" {
+		return errUsage
+	}
+	m, err := parseMoney(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(*ratesFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	rates, err := pricing.ReadExchangeRates(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *ratesFile, err)
+	}
+
+	converted, err := pricing.Convert(m, *to, rates)
+	if err != nil {
+		return err
+	}
+	return printText(stdout, converted)
+}
+
+// format formats money with its currency symbol and separators
+func format(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	m, err := parseMoney(flags.Args())
+	if err != nil {
+		return err
+	}
+	text, err := pricing.Format(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, text)
+	return err
+}
+
+// parse parses money written with its currency symbol and separators
+func parse(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	currency := flags.String("currency", "", "currency of the amount")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *currency == "" || flags.NArg() == 0 {
+		return errUsage
+	}
+	m, err := pricing.Parse(strings.Join(flags.Args(), " "), *currency)
+	if err != nil {
+		return err
+	}
+	return printText(stdout, m)
+}
+
+// discount applies a percentage discount to money or taxed money
+func discount(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	percent := flags.Float64("percent", 0, "discount in percent")
+	fromGross := flags.Bool("from-gross", false, "compute the discount from gross of taxed money")
+	roundingName := flags.String("rounding", "up", "rounding of the discounted amount: up, down, ceil or floor")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	rounding, ok := roundings[*roundingName]
+	if !ok || flags.NArg() == 0 {
+		return errUsage
+	}
+
+	text := strings.Join(flags.Args(), " ")
+	if strings.Contains(text, "/") {
+		var taxed goprices.TaxedMoney
+		if err := taxed.UnmarshalText([]byte(text)); err != nil {
+			return err
+		}
+		res, err := goprices.PercentageDiscount(taxed, *percent, *fromGross, rounding)
+		if err != nil {
+			return err
+		}
+		return printText(stdout, res)
+	}
+
+	m, err := parseMoney(flags.Args())
+	if err != nil {
+		return err
+	}
+	res, err := goprices.PercentageDiscount(m, *percent, *fromGross, rounding)
+	if err != nil {
+		return err
+	}
+	return printText(stdout, res)
+}
+
+// tax computes taxed money from net, or from gross
+func tax(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	rate := flags.Float64("rate", 0, "tax rate in percent")
+	fromGross := flags.Bool("from-gross", false, "the given money is gross, tax included")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	m, err := parseMoney(flags.Args())
+	if err != nil {
+		return err
+	}
+	taxed, err := pricing.FlatTax(m, decimal.NewFromFloat(*rate).Shift(-2), *fromGross)
+	if err != nil {
+		return err
+	}
+	return printText(stdout, taxed)
+}
+
+// split splits money into equal parts, or parts proportional to given ratios
+func split(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	parts := flags.Int("parts", 2, "number of equal parts")
+	ratioList := flags.String("ratios", "", "comma separated ratios of parts, e.g: 1,2,3")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	m, err := parseMoney(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	var res []goprices.Money
+	if *ratioList == "" {
+		res, err = pricing.Split(m, *parts)
+	} else {
+		var ratios []int
+		for _, field := range strings.Split(*ratioList, ",") {
+			ratio, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				return errUsage
+			}
+			ratios = append(ratios, ratio)
+		}
+		res, err = pricing.Allocate(m, ratios...)
+	}
+	if err != nil {
+		return err
+	}
+	for _, part := range res {
+		if err := printText(stdout, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currencies lists known currencies
+func currencies(flags *flag.FlagSet, args []string, stdout io.Writer) error {
+	if len(args) == 0 || args[0] != "list" {
+		return errUsage
+	}
+	active := flags.Bool("active", false, "list active currencies only")
+	if err := parseFlags(flags, args[1:]); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "CODE\tNUMERIC\tFRACTION\tSYMBOL\tNAME")
+	for _, c := range goprices.AllCurrencies() {
+		if *active && !c.IsActive() {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", c.Code, c.NumericCode, c.Fraction, c.Grapheme, c.Name)
+	}
+	return w.Flush()
+}
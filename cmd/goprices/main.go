@@ -0,0 +1,121 @@
+// Command goprices performs price calculations exactly as the go-prices library does.
+//
+// Usage:
+//
+//	goprices <command> [flags] [arguments]
+//
+// Money is given as a currency code followed by an amount, e.g: "USD 12.50",
+// and taxed money with net and gross amounts, e.g: "USD 10/12.30".
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	goprices "github.com/site-name/go-prices"
+)
+
+// command runs a sub command with its arguments, writing results to stdout
+type command struct {
+	usage string
+	run   func(flags *flag.FlagSet, args []string, stdout io.Writer) error
+}
+
+var commands = map[string]command{
+	"convert":    {"convert -rates FILE -to CURRENCY MONEY", convert},
+	"format":     {"format MONEY", format},
+	"parse":      {"parse -currency CURRENCY TEXT", parse},
+	"discount":   {"discount -percent PERCENT [-from-gross] [-rounding up|down|ceil|floor] MONEY|TAXED_MONEY", discount},
+	"tax":        {"tax -rate PERCENT [-from-gross] MONEY", tax},
+	"split":      {"split [-parts N | -ratios R1,R2,...] MONEY", split},
+	"currencies": {"currencies list [-active]", currencies},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command given by args and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "goprices: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: goprices %s\n", cmd.usage)
+		flags.PrintDefaults()
+	}
+	if err := cmd.run(flags, args[1:], stdout); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		if errors.Is(err, errUsage) {
+			flags.Usage()
+			return 2
+		}
+		fmt.Fprintf(stderr, "goprices %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "usage: goprices <command> [flags] [arguments]")
+	fmt.Fprintln(w, "commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\n", commands[name].usage)
+	}
+}
+
+// errUsage is returned by commands given invalid flags or arguments
+var errUsage = errors.New("invalid usage")
+
+// parseFlags parses flags of a command, flag errors are already reported by the flag set
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	return nil
+}
+
+// parseMoney parses money from arguments, e.g: ["USD", "12.50"]
+func parseMoney(args []string) (goprices.Money, error) {
+	var m goprices.Money
+	if len(args) == 0 {
+		return m, errUsage
+	}
+	err := m.UnmarshalText([]byte(strings.Join(args, " ")))
+	return m, err
+}
+
+func printText(stdout io.Writer, value interface{ MarshalText() ([]byte, error) }) error {
+	text, err := value.MarshalText()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(stdout, "%s\n", text)
+	return err
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	rates := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(rates, []byte(`{"base":"USD","rates":{"EUR":"0.92"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	type testUnit struct {
+		args     []string
+		expected string
+		code     int
+	}
+	for index, unit := range []testUnit{
+		{[]string{"convert", "-rates", rates, "-to", "USD", "EUR", "10"}, "USD 10.87\n", 0},
+		{[]string{"convert", "--rates", rates, "--to", "GBP", "EUR", "10"}, "", 1},
+		{[]string{"convert", "-to", "USD", "EUR", "10"}, "", 2},
+		{[]string{"format", "USD", "1234.5"}, "$1,234.50\n", 0},
+		{[]string{"parse", "-currency", "BRL", "R$1.234,50"}, "BRL 1234.50\n", 0},
+		{[]string{"discount", "--percent", "15", "USD", "10"}, "USD 8.50\n", 0},
+		{[]string{"discount", "--percent", "15", "--from-gross", "USD", "10/12"}, "USD 8.20/10.20\n", 0},
+		{[]string{"discount", "--percent", "15", "--rounding", "sideways", "USD", "10"}, "", 2},
+		{[]string{"tax", "--rate", "20", "USD", "10"}, "USD 10.00/12.00\n", 0},
+		{[]string{"tax", "--rate", "20", "--from-gross", "USD", "10"}, "USD 8.33/10.00\n", 0},
+		{[]string{"split", "--parts", "3", "USD", "10"}, "USD 3.34\nUSD 3.33\nUSD 3.33\n", 0},
+		{[]string{"split", "--ratios", "3,7", "USD", "0.05"}, "USD 0.02\nUSD 0.03\n", 0},
+		{[]string{"split", "--parts", "0", "USD", "10"}, "", 1},
+		{[]string{"format", "ABC", "1"}, "", 1},
+		{[]string{"format"}, "", 2},
+		{[]string{"unknown"}, "", 2},
+		{nil, "", 2},
+	} {
+		var stdout, stderr strings.Builder
+		code := run(unit.args, &stdout, &stderr)
+		if code != unit.code {
+			t.Fatalf("Error at index: %d, expected exit code: %d, got: %d, stderr: %s", index, unit.code, code, stderr.String())
+		}
+		if stdout.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %q, got: %q", index, unit.expected, stdout.String())
+		}
+	}
+}
+
+func TestCurrenciesList(t *testing.T) {
+	var stdout, stderr strings.Builder
+	if code := run([]string{"currencies", "list", "--active"}, &stdout, &stderr); code != 0 {
+		t.Fatalf("expected exit code 0, got: %d, stderr: %s", code, stderr.String())
+	}
+	lines := strings.Split(stdout.String(), "\n")
+	if !strings.HasPrefix(lines[0], "CODE") {
+		t.Fatalf("expected a header, got: %s", lines[0])
+	}
+	if !strings.Contains(stdout.String(), "\nUSD ") {
+		t.Fatalf("expected USD to be listed, got: %s", stdout.String())
+	}
+
+	if code := run([]string{"currencies"}, &stdout, &stderr); code != 2 {
+		t.Fatalf("expected exit code 2, got: %d", code)
+	}
+}
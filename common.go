@@ -15,8 +15,12 @@ type MoneyInterface[T MoneyObject] interface {
 	String() string
 	GetCurrency() string
 	Quantize(round Rounding, exp int) (*T, error) // NOTE: if exp < 0, system wil use default
-	fixedDiscount(discount Money) (*T, error)
-	fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (*T, error)
+	quantize(round Rounding, exp int) (T, error)
+	fixedDiscount(discount Money) (T, error)
+	fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (T, error)
+	cappedFractionalDiscount(fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (T, error)
+	applyPriceEnding(ending PriceEnding) (T, error)
+	scale(numerator, denominator decimal.Decimal, rounding Rounding) (T, error)
 	Neg() T
 }
 
@@ -25,3 +29,8 @@ type MoneyInterface[T MoneyObject] interface {
 func QuantizePrice[K MoneyObject, T MoneyInterface[K]](price T, round Rounding) (*K, error) {
 	return price.Quantize(round, -1)
 }
+
+// MustQuantizePrice is like QuantizePrice but returns a value and panics if error occurs.
+func MustQuantizePrice[K MoneyObject, T MoneyInterface[K]](price T, round Rounding) K {
+	return must(price.quantize(round, -1))
+}
@@ -0,0 +1,110 @@
+package goprices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CompareMoney returns -1, 0 or +1 if a is less than, equal to or greater than b.
+// Money of different currencies are ordered by currency code first, so the result is a total order,
+// suitable for slices.SortFunc, sort.Slice...
+func CompareMoney(a, b Money) int {
+	if c := strings.Compare(strings.ToUpper(a.currency), strings.ToUpper(b.currency)); c != 0 {
+		return c
+	}
+	return a.amount.Cmp(b.amount)
+}
+
+// CompareTaxedMoney returns a compare function like CompareMoney,
+// comparing gross if fromGross is true, net otherwise.
+func CompareTaxedMoney(fromGross bool) func(a, b TaxedMoney) int {
+	if fromGross {
+		return func(a, b TaxedMoney) int { return CompareMoney(a.gross, b.gross) }
+	}
+	return func(a, b TaxedMoney) int { return CompareMoney(a.net, b.net) }
+}
+
+// Min returns the smallest of given money.
+//
+// Returned error could be `nil`, `ErrNoValues` or a *CurrencyMismatchError
+func Min(values ...Money) (*Money, error) {
+	return ptr(pick(values, Money.Compare, -1))
+}
+
+// Max returns the largest of given money.
+//
+// Returned error could be `nil`, `ErrNoValues` or a *CurrencyMismatchError
+func Max(values ...Money) (*Money, error) {
+	return ptr(pick(values, Money.Compare, 1))
+}
+
+// Clamp returns lo if m < lo, hi if m > hi, m otherwise.
+//
+// Returned error could be `nil`, a *CurrencyMismatchError or an *InvalidRangeError if hi < lo
+func Clamp(m, lo, hi Money) (*Money, error) {
+	return ptr(clamp(m, lo, hi, Money.Compare))
+}
+
+// MinTaxedMoney returns the smallest of given taxed money, comparing gross if fromGross is true, net otherwise.
+//
+// Returned error could be `nil`, `ErrNoValues` or a *CurrencyMismatchError
+func MinTaxedMoney(fromGross bool, values ...TaxedMoney) (*TaxedMoney, error) {
+	return ptr(pick(values, taxedCompare(fromGross), -1))
+}
+
+// MaxTaxedMoney returns the largest of given taxed money, comparing gross if fromGross is true, net otherwise.
+//
+// Returned error could be `nil`, `ErrNoValues` or a *CurrencyMismatchError
+func MaxTaxedMoney(fromGross bool, values ...TaxedMoney) (*TaxedMoney, error) {
+	return ptr(pick(values, taxedCompare(fromGross), 1))
+}
+
+// ClampTaxedMoney is like Clamp, comparing gross if fromGross is true, net otherwise.
+func ClampTaxedMoney(t, lo, hi TaxedMoney, fromGross bool) (*TaxedMoney, error) {
+	return ptr(clamp(t, lo, hi, taxedCompare(fromGross)))
+}
+
+func taxedCompare(fromGross bool) func(a, b TaxedMoney) (int, error) {
+	return func(a, b TaxedMoney) (int, error) {
+		return a.Compare(b, fromGross)
+	}
+}
+
+// pick returns the smallest (sign is -1) or largest (sign is +1) of given values.
+func pick[T any](values []T, compare func(a, b T) (int, error), sign int) (T, error) {
+	if len(values) == 0 {
+		var zero T
+		return zero, ErrNoValues
+	}
+
+	res := values[0]
+	for _, value := range values[1:] {
+		c, err := compare(value, res)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if c == sign {
+			res = value
+		}
+	}
+	return res, nil
+}
+
+func clamp[T fmt.Stringer](value, lo, hi T, compare func(a, b T) (int, error)) (T, error) {
+	c, err := compare(lo, hi)
+	if err != nil {
+		return value, err
+	}
+	if c > 0 {
+		return value, &InvalidRangeError{Start: lo, Stop: hi}
+	}
+
+	if c, err = compare(value, lo); err != nil || c < 0 {
+		return lo, err
+	}
+	if c, err = compare(value, hi); err != nil || c > 0 {
+		return hi, err
+	}
+	return value, nil
+}
@@ -0,0 +1,118 @@
+package goprices
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestMoneyCompare(t *testing.T) {
+	one := *must(NewMoney(1, USD))
+	two := *must(NewMoney(2, USD))
+
+	if c, err := one.Compare(two); err != nil || c != -1 {
+		t.Fatalf("expected -1, got: %d, %v", c, err)
+	}
+	if c, err := two.Compare(one); err != nil || c != 1 {
+		t.Fatalf("expected 1, got: %d, %v", c, err)
+	}
+	if !two.GreaterThan(one) || !two.GreaterThanOrEqual(two) || one.GreaterThan(two) {
+		t.Fatal("unexpected GreaterThan result")
+	}
+
+	eur := *must(NewMoney(1, EUR))
+	if _, err := one.Compare(eur); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+	if eur.GreaterThan(one) || eur.GreaterThanOrEqual(one) {
+		t.Fatal("money of different currencies must not be comparable")
+	}
+}
+
+func TestMinMaxClamp(t *testing.T) {
+	one := *must(NewMoney(1, USD))
+	two := *must(NewMoney(2, USD))
+	three := *must(NewMoney(3, USD))
+
+	if min := must(Min(two, one, three)); !min.Equal(one) {
+		t.Fatalf("expected: %s, got: %s", one, min)
+	}
+	if max := must(Max(two, three, one)); !max.Equal(three) {
+		t.Fatalf("expected: %s, got: %s", three, max)
+	}
+	if _, err := Min(); !errors.Is(err, ErrNoValues) {
+		t.Fatalf("expected ErrNoValues, got: %v", err)
+	}
+	if _, err := Max(one, *must(NewMoney(1, EUR))); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+
+	type testUnit struct {
+		value, expected Money
+	}
+	for index, unit := range []testUnit{
+		{one, two},
+		{two, two},
+		{three, three},
+		{*must(NewMoney(4, USD)), three},
+	} {
+		if res := must(Clamp(unit.value, two, three)); !res.Equal(unit.expected) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, res)
+		}
+	}
+	if _, err := Clamp(one, three, two); !errors.Is(err, ErrStopLessThanStart) {
+		t.Fatalf("expected ErrStopLessThanStart, got: %v", err)
+	}
+}
+
+func TestTaxedMoneyCompare(t *testing.T) {
+	// a has lower net but higher gross than b
+	a := *must(NewTaxedMoneyFromFloats(10, 13, USD))
+	b := *must(NewTaxedMoneyFromFloats(11, 12, USD))
+
+	if c, _ := a.Compare(b, false); c != -1 {
+		t.Fatalf("expected -1, got: %d", c)
+	}
+	if c, _ := a.Compare(b, true); c != 1 {
+		t.Fatalf("expected 1, got: %d", c)
+	}
+	if min := must(MinTaxedMoney(false, a, b)); !min.Equal(a) {
+		t.Fatalf("expected: %s, got: %s", a, min)
+	}
+	if min := must(MinTaxedMoney(true, a, b)); !min.Equal(b) {
+		t.Fatalf("expected: %s, got: %s", b, min)
+	}
+	if max := must(MaxTaxedMoney(true, a, b)); !max.Equal(a) {
+		t.Fatalf("expected: %s, got: %s", a, max)
+	}
+	if res := must(ClampTaxedMoney(a, b, b, false)); !res.Equal(b) {
+		t.Fatalf("expected: %s, got: %s", b, res)
+	}
+}
+
+func TestCompareMoneySort(t *testing.T) {
+	values := []Money{
+		*must(NewMoney(3, USD)),
+		*must(NewMoney(1, USD)),
+		*must(NewMoney(2, EUR)),
+		*must(NewMoney(2, USD)),
+	}
+	sort.Slice(values, func(i, j int) bool { return CompareMoney(values[i], values[j]) < 0 })
+
+	expected := []string{"2 EUR", "1 USD", "2 USD", "3 USD"}
+	for index, value := range values {
+		if got := value.amount.String() + " " + value.currency; got != expected[index] {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, expected[index], got)
+		}
+	}
+
+	taxed := []TaxedMoney{
+		*must(NewTaxedMoneyFromFloats(10, 13, USD)),
+		*must(NewTaxedMoneyFromFloats(11, 12, USD)),
+	}
+	byGross := CompareTaxedMoney(true)
+	sort.Slice(taxed, func(i, j int) bool { return byGross(taxed[i], taxed[j]) < 0 })
+	if !taxed[0].gross.amount.Equal(must(NewMoney(12, USD)).amount) {
+		t.Fatalf("expected taxed money with lower gross first, got: %s", taxed[0])
+	}
+}
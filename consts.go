@@ -7,14 +7,32 @@ import (
 )
 
 var (
-	ErrNotSameCurrency   = errors.New("not same currency")     // ErrNotSameCurrency is used when perform operations between money with different currencies
-	ErrUnknownType       = errors.New("unknown given type")    // ErrUnknownType is returned when a type is invalid
-	ErrUnknownCurrency   = errors.New("unknown currency unit") // ErrUnknownCurrency is returned when given currency unit is invalid
-	ErrNillValue         = errors.New("argument must not be nil")
-	ErrDivisorZero       = errors.New("divisor must not be zero")
-	ErrInvalidRounding   = errors.New("invalid rounding")
-	ErrMoneyNegative     = errors.New("money amount can not be negative")
-	ErrStopLessThanStart = errors.New("stop must be greater than start")
+	ErrNotSameCurrency    = errors.New("not same currency")     // ErrNotSameCurrency is used when perform operations between money with different currencies
+	ErrUnknownType        = errors.New("unknown given type")    // ErrUnknownType is returned when a type is invalid
+	ErrUnknownCurrency    = errors.New("unknown currency unit") // ErrUnknownCurrency is returned when given currency unit is invalid
+	ErrNillValue          = errors.New("argument must not be nil")
+	ErrDivisorZero        = errors.New("divisor must not be zero")
+	ErrInvalidRounding    = errors.New("invalid rounding")
+	ErrMoneyNegative      = errors.New("money amount can not be negative")
+	ErrStopLessThanStart  = errors.New("stop must not be less than start")
+	ErrInvalidPriceEnding = errors.New("invalid price ending")
+	ErrInvalidMargin      = errors.New("margin must be less than 1")
+	ErrInvalidMarkup      = errors.New("markup must not be less than -1")
+	ErrPrecisionLoss      = errors.New("amount has more decimal places than its currency allows")
+	ErrOverflow           = errors.New("amount overflows")
+	ErrInvalidCurrency    = errors.New("invalid currency")
+	ErrCurrencyRegistered = errors.New("currency already registered")
+	ErrUnknownRegion      = errors.New("unknown region")
+	ErrNoValues           = errors.New("at least one value must be given")
+	ErrRangesDisjoint     = errors.New("ranges do not overlap")
+	ErrInvalidFacet       = errors.New("invalid facet options")
+	ErrInvalidBound       = errors.New("invalid range bound")
+	ErrRangeUnbounded     = errors.New("range is unbounded")
+	ErrInvalidProtoMoney  = errors.New("invalid google.type.Money: nanos out of range or sign differs from units")
+	ErrInvalidEncoding    = errors.New("invalid money encoding")
+	ErrGrossLessThanNet   = errors.New("gross must not be less than net")
+	ErrMissingColumn      = errors.New("missing column")
+	ErrNoCurrency         = errors.New("no currency in use")
 )
 
 type RoundFunc func(places int32) decimal.Decimal
@@ -124,10 +142,13 @@ const (
 	LYD = "LYD"
 	MAD = "MAD"
 	MDL = "MDL"
+	MGA = "MGA"
 	MKD = "MKD"
 	MMK = "MMK"
 	MNT = "MNT"
 	MOP = "MOP"
+	MRO = "MRO"
+	MRU = "MRU"
 	MUR = "MUR"
 	MVR = "MVR"
 	MWK = "MWK"
@@ -162,11 +183,13 @@ const (
 	SGD = "SGD"
 	SHP = "SHP"
 	SKK = "SKK"
+	SLE = "SLE"
 	SLL = "SLL"
 	SOS = "SOS"
 	SRD = "SRD"
 	SSP = "SSP"
 	STD = "STD"
+	STN = "STN"
 	SVC = "SVC"
 	SYP = "SYP"
 	SZL = "SZL"
@@ -184,21 +207,46 @@ const (
 	UGX = "UGX"
 	USD = "USD"
 	UYU = "UYU"
+	UYW = "UYW"
 	UZS = "UZS"
+	VED = "VED"
 	VEF = "VEF"
+	VES = "VES"
 	VND = "VND"
 	VUV = "VUV"
 	WST = "WST"
 	XAF = "XAF"
 	XAG = "XAG"
 	XAU = "XAU"
+	XBA = "XBA"
+	XBB = "XBB"
+	XBC = "XBC"
+	XBD = "XBD"
 	XCD = "XCD"
 	XDR = "XDR"
+	XOF = "XOF"
+	XPD = "XPD"
 	XPF = "XPF"
+	XPT = "XPT"
+	XSU = "XSU"
+	XTS = "XTS"
+	XUA = "XUA"
+	XXX = "XXX"
 	YER = "YER"
 	ZAR = "ZAR"
 	ZMW = "ZMW"
 	ZWD = "ZWD"
+	ZWG = "ZWG"
+	ZWL = "ZWL"
+)
+
+// cryptocurrencies and stablecoins, they are not part of ISO 4217
+const (
+	BTC  = "BTC"
+	ETH  = "ETH"
+	USDT = "USDT"
+	USDC = "USDC"
+	DAI  = "DAI"
 )
 
 // CurrenciesMap has keys are currency codes of most countries in the world.
@@ -364,15 +412,24 @@ var CurrenciesMap = map[string]string{
 	XAU: "Gold Ounce",
 	XCD: "East Caribbean Dollar",
 	XDR: "Special Drawing Rights",
-	// XOF: "CFA Franc BCEAO",
-	// XPD: "Palladium Ounce",
-	// VES: "Venezuelan Bolívar Soberano",
-	// STN: "São Tomé and Príncipe Dobra",
-	// MRO: "Mauritanian Ouguiya (pre-2018)",
-	// MRU: "Mauritanian Ouguiya",
-	// MGA: "Malagasy Ariary",
+	XOF: "CFA Franc BCEAO",
+	XPD: "Palladium Ounce",
+	VES: "Venezuelan Bolívar Soberano",
+	STN: "São Tomé and Príncipe Dobra",
+	MRO: "Mauritanian Ouguiya (pre-2018)",
+	MRU: "Mauritanian Ouguiya",
+	MGA: "Malagasy Ariary",
 	// CNH: "Chinese Yuan (Offshore)",
-	// BTC: "Bitcoin",
-	// XPT: "Platinum Ounce",
-	// ZWL: "Zimbabwean Dollar",
+	VED: "Venezuelan Bolívar Digital",
+	SLE: "Sierra Leonean Leone",
+	UYW: "Uruguayan Nominal Wage Index Unit",
+	ZWG: "Zimbabwe Gold",
+	XPT: "Platinum Ounce",
+	ZWL: "Zimbabwean Dollar (2009-2024)",
+
+	BTC:  "Bitcoin",
+	ETH:  "Ether",
+	USDT: "Tether USD",
+	USDC: "USD Coin",
+	DAI:  "Dai",
 }
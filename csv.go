@@ -0,0 +1,288 @@
+package goprices
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/site-name/decimal"
+	"github.com/site-name/go-prices/internal/numfmt"
+)
+
+// price list column names, matched case-insensitively by PriceListReader
+const (
+	columnSKU      = "sku"
+	columnNet      = "net"
+	columnGross    = "gross"
+	columnCurrency = "currency"
+	columnMin      = "min"
+	columnMax      = "max"
+)
+
+// PriceListOptions configures PriceListReader and PriceListWriter
+type PriceListOptions struct {
+	// Comma is the field delimiter, ',' if zero. Localized price lists often use ';'
+	Comma rune
+	// Localized tells amounts use the Decimal and Thousand separators of their currency, e.g: "1.234,50" for BRL
+	Localized bool
+	// Range tells PriceListWriter to write min and max columns
+	Range bool
+}
+
+// PriceListRow is a row of a price list
+type PriceListRow struct {
+	// Line of the row in the file, starting at 1. It is set by PriceListReader
+	Line int
+	SKU  string
+	// Price is built from net and gross columns, when one of them is missing or empty it equals the other
+	Price TaxedMoney
+	// Range is built from min and max columns, an empty cell is an unbounded end.
+	// It is nil when both are missing or empty.
+	Range *MoneyRange
+}
+
+// PriceListReader reads price list rows from a CSV file one at a time, so large files can be streamed.
+//
+// The first row is a header naming the columns: currency and at least one of net and gross are required,
+// sku, min and max are optional, other columns are ignored.
+type PriceListReader struct {
+	r       *csv.Reader
+	options PriceListOptions
+	columns map[string]int
+	err     error
+}
+
+// NewPriceListReader returns a reader reading from r
+func NewPriceListReader(r io.Reader, options PriceListOptions) *PriceListReader {
+	reader := csv.NewReader(r)
+	if options.Comma != 0 {
+		reader.Comma = options.Comma
+	}
+	reader.ReuseRecord = true
+	return &PriceListReader{r: reader, options: options}
+}
+
+// Read reads the next row. It returns io.EOF when there are no more rows.
+//
+// An invalid row is reported with a *RowError, e.g: wrapping an *UnknownCurrencyError, ErrMoneyNegative
+// or ErrGrossLessThanNet, reading can continue with the next row then.
+// Any other error, e.g: a RowError wrapping ErrMissingColumn for an invalid header, is returned again by later calls.
+func (r *PriceListReader) Read() (PriceListRow, error) {
+	if r.err != nil {
+		return PriceListRow{}, r.err
+	}
+	if r.columns == nil {
+		if r.err = r.readHeader(); r.err != nil {
+			return PriceListRow{}, r.err
+		}
+	}
+
+	record, err := r.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return PriceListRow{}, &RowError{Line: parseErr.StartLine, Err: parseErr.Err}
+		}
+		r.err = err
+		return PriceListRow{}, err
+	}
+	line, _ := r.r.FieldPos(0)
+	return r.parseRow(record, line)
+}
+
+func (r *PriceListReader) readHeader() error {
+	header, err := r.r.Read()
+	if err != nil {
+		return err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	line, _ := r.r.FieldPos(0)
+	if _, ok := columns[columnCurrency]; !ok {
+		return &RowError{Line: line, Column: columnCurrency, Err: ErrMissingColumn}
+	}
+	_, hasNet := columns[columnNet]
+	if _, hasGross := columns[columnGross]; !hasNet && !hasGross {
+		return &RowError{Line: line, Column: columnNet, Err: ErrMissingColumn}
+	}
+	r.columns = columns
+	return nil
+}
+
+// cell returns trimmed value of given column, or an empty string if the column is missing
+func (r *PriceListReader) cell(record []string, column string) string {
+	i, ok := r.columns[column]
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// amount parses given column as a non negative amount, ok is false when the cell is missing or empty
+func (r *PriceListReader) amount(record []string, column string, currency Currency) (amount Money, ok bool, err error) {
+	text := r.cell(record, column)
+	if text == "" {
+		return Money{}, false, nil
+	}
+	if r.options.Localized {
+		text = numfmt.Delocalize(text, currency.Decimal, currency.Thousand)
+	}
+	value, err := parseAmount(text)
+	if err != nil {
+		return Money{}, false, err
+	}
+	if value.IsNegative() {
+		return Money{}, false, ErrMoneyNegative
+	}
+	return Money{value, currency.Code}, true, nil
+}
+
+func (r *PriceListReader) parseRow(record []string, line int) (PriceListRow, error) {
+	rowError := func(column string, err error) (PriceListRow, error) {
+		return PriceListRow{}, &RowError{Line: line, Column: column, Err: err}
+	}
+
+	code, err := validateCurrency(r.cell(record, columnCurrency))
+	if err != nil {
+		return rowError(columnCurrency, err)
+	}
+	currency, err := GetCurrency(code)
+	if err != nil {
+		return rowError(columnCurrency, err)
+	}
+
+	net, hasNet, err := r.amount(record, columnNet, currency)
+	if err != nil {
+		return rowError(columnNet, err)
+	}
+	gross, hasGross, err := r.amount(record, columnGross, currency)
+	if err != nil {
+		return rowError(columnGross, err)
+	}
+	switch {
+	case !hasNet && !hasGross:
+		return rowError(columnNet, ErrMissingColumn)
+	case !hasNet:
+		net = gross
+	case !hasGross:
+		gross = net
+	case gross.LessThan(net):
+		return rowError(columnGross, ErrGrossLessThanNet)
+	}
+
+	row := PriceListRow{
+		Line:  line,
+		SKU:   r.cell(record, columnSKU),
+		Price: TaxedMoney{net, gross},
+	}
+
+	min, hasMin, err := r.amount(record, columnMin, currency)
+	if err != nil {
+		return rowError(columnMin, err)
+	}
+	max, hasMax, err := r.amount(record, columnMax, currency)
+	if err != nil {
+		return rowError(columnMax, err)
+	}
+	if hasMin || hasMax {
+		startBound, stopBound := Inclusive, Inclusive
+		if !hasMin {
+			startBound, min = Unbounded, Money{decimal.Zero, code}
+		}
+		if !hasMax {
+			stopBound, max = Unbounded, Money{decimal.Zero, code}
+		}
+		// min and max are valid on their own, so the error concerns the range as a whole, e.g: min greater than max
+		priceRange, err := newMoneyRange(min, max, startBound, stopBound)
+		if err != nil {
+			return rowError("", err)
+		}
+		row.Range = &priceRange
+	}
+	return row, nil
+}
+
+// PriceListWriter writes price list rows to a CSV file, with a header naming the columns:
+// sku, net, gross, currency, followed by min and max if PriceListOptions.Range is set.
+type PriceListWriter struct {
+	w             *csv.Writer
+	options       PriceListOptions
+	headerWritten bool
+}
+
+// NewPriceListWriter returns a writer writing to w. Flush must be called after the last row.
+func NewPriceListWriter(w io.Writer, options PriceListOptions) *PriceListWriter {
+	writer := csv.NewWriter(w)
+	if options.Comma != 0 {
+		writer.Comma = options.Comma
+	}
+	return &PriceListWriter{w: writer, options: options}
+}
+
+// Write writes given row, the header is written before the first one.
+// Ranges must have inclusive or unbounded ends, unbounded ends are written as empty cells.
+//
+// Returned error could be `nil`, `ErrInvalidBound`, an *UnknownCurrencyError, a *CurrencyMismatchError or a write error
+func (w *PriceListWriter) Write(row PriceListRow) error {
+	currency, err := GetCurrency(row.Price.GetCurrency())
+	if err != nil {
+		return err
+	}
+	record := []string{
+		row.SKU,
+		w.formatAmount(row.Price.net, currency),
+		w.formatAmount(row.Price.gross, currency),
+		currency.Code,
+	}
+
+	if w.options.Range {
+		min, max := "", ""
+		if row.Range != nil {
+			if err := checkCurrencies([2]string{currency.Code, row.Range.GetCurrency()}); err != nil {
+				return err
+			}
+			if row.Range.startBound == Exclusive || row.Range.stopBound == Exclusive {
+				return ErrInvalidBound
+			}
+			if row.Range.startBound != Unbounded {
+				min = w.formatAmount(row.Range.start, currency)
+			}
+			if row.Range.stopBound != Unbounded {
+				max = w.formatAmount(row.Range.stop, currency)
+			}
+		}
+		record = append(record, min, max)
+	}
+
+	if !w.headerWritten {
+		header := []string{columnSKU, columnNet, columnGross, columnCurrency}
+		if w.options.Range {
+			header = append(header, columnMin, columnMax)
+		}
+		if err := w.w.Write(header); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	return w.w.Write(record)
+}
+
+// Flush writes any buffered rows to the underlying writer
+func (w *PriceListWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *PriceListWriter) formatAmount(m Money, currency Currency) string {
+	if w.options.Localized {
+		return numfmt.Localize(formatAmount(m), currency.Decimal, currency.Thousand)
+	}
+	return formatAmount(m)
+}
@@ -0,0 +1,154 @@
+package goprices
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPriceListReader(t *testing.T) {
+	data := "\ufeffSKU,Net,Gross,Currency,Min,Max,Note\n" +
+		"A1,10,12.3,usd,,,first\n" +
+		"A2,5,,EUR,1,8,\n" +
+		"A3,1,2,ABC,,,\n" +
+		"A4,-1,2,USD,,,\n" +
+		"A5,3,2,USD,,,\n" +
+		"A6,1,1,USD,5,,\n" +
+		"A7,1,1,USD,5,4,\n" +
+		"A8,1,1,USD,-5,4,\n" +
+		"A9,1,1\n" +
+		"A10,,,USD,,,\n"
+
+	type testUnit struct {
+		row    string
+		column string
+		err    error
+	}
+	expected := []testUnit{
+		{row: "A1 USD 10.00/12.30 <nil>"},
+		{row: "A2 EUR 5.00/5.00 MoneyRange[Money{1, EUR}, Money{8, EUR}]"},
+		{column: "currency", err: ErrUnknownCurrency},
+		{column: "net", err: ErrMoneyNegative},
+		{column: "gross", err: ErrGrossLessThanNet},
+		{row: "A6 USD 1.00/1.00 MoneyRange[Money{5, USD}, +inf)"},
+		{err: ErrStopLessThanStart},
+		{column: "min", err: ErrMoneyNegative},
+		{err: csv.ErrFieldCount},
+		{column: "net", err: ErrMissingColumn},
+	}
+
+	reader := NewPriceListReader(strings.NewReader(data), PriceListOptions{})
+	for index, unit := range expected {
+		row, err := reader.Read()
+		if unit.err == nil {
+			if err != nil {
+				t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+			}
+			text, _ := row.Price.MarshalText()
+			rangeText := "<nil>"
+			if row.Range != nil {
+				rangeText = row.Range.String()
+			}
+			if got := row.SKU + " " + string(text) + " " + rangeText; got != unit.row {
+				t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.row, got)
+			}
+			if row.Line != index+2 {
+				t.Fatalf("Error at index: %d, expected line: %d, got: %d", index, index+2, row.Line)
+			}
+			continue
+		}
+
+		var rowErr *RowError
+		if !errors.As(err, &rowErr) {
+			t.Fatalf("Error at index: %d, expected a *RowError, got: %v", index, err)
+		}
+		if rowErr.Line != index+2 || rowErr.Column != unit.column {
+			t.Fatalf("Error at index: %d, expected line %d and column %q, got: %v", index, index+2, unit.column, err)
+		}
+		if !errors.Is(err, unit.err) {
+			t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+		}
+	}
+	if _, err := reader.Read(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got: %v", err)
+	}
+}
+
+func TestPriceListReaderHeader(t *testing.T) {
+	for index, data := range []string{"sku,net,gross\n", "sku,currency\n"} {
+		reader := NewPriceListReader(strings.NewReader(data), PriceListOptions{})
+		for i := 0; i < 2; i++ {
+			if _, err := reader.Read(); !errors.Is(err, ErrMissingColumn) {
+				t.Fatalf("Error at index: %d, expected ErrMissingColumn, got: %v", index, err)
+			}
+		}
+	}
+}
+
+func TestPriceListLocalized(t *testing.T) {
+	data := "sku;net;gross;currency\n" +
+		"A1;1.234,5;1.469,06;BRL\n" +
+		"A2;1 000,5;1 000,5;BYN\n" +
+		"A3;1,234.5;1,234.5;USD\n"
+	reader := NewPriceListReader(strings.NewReader(data), PriceListOptions{Comma: ';', Localized: true})
+
+	var buf strings.Builder
+	writer := NewPriceListWriter(&buf, PriceListOptions{Comma: ';', Localized: true})
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writer.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "sku;net;gross;currency\n" +
+		"A1;1.234,50;1.469,06;BRL\n" +
+		"A2;1 000,50;1 000,50;BYN\n" +
+		"A3;1,234.50;1,234.50;USD\n"
+	if buf.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestPriceListWriter(t *testing.T) {
+	var buf strings.Builder
+	writer := NewPriceListWriter(&buf, PriceListOptions{Range: true})
+	priceRange := *must(NewMoneyRangeTo(*must(NewMoney(20, USD)), Inclusive))
+	rows := []PriceListRow{
+		{SKU: "A1", Price: *must(NewTaxedMoneyFromFloats(10, 12.3, USD)), Range: &priceRange},
+		{SKU: "A2", Price: *must(NewTaxedMoneyFromFloats(1234.5, 1234.5, JPY))},
+	}
+	for _, row := range rows {
+		if err := writer.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "sku,net,gross,currency,min,max\n" +
+		"A1,10.00,12.30,USD,,20.00\n" +
+		"A2,1234.5,1234.5,JPY,,\n"
+	if buf.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	exclusive := *must(NewMoneyRangeTo(*must(NewMoney(20, USD)), Exclusive))
+	if err := writer.Write(PriceListRow{Price: rows[0].Price, Range: &exclusive}); !errors.Is(err, ErrInvalidBound) {
+		t.Fatalf("expected ErrInvalidBound, got: %v", err)
+	}
+	if err := writer.Write(PriceListRow{Price: rows[1].Price, Range: &priceRange}); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestCryptoCurrencies(t *testing.T) {
+	type testUnit struct {
+		currency string
+		expected int
+	}
+	testCases := []testUnit{
+		{"btc", 8},
+		{ETH, 18},
+		{USDT, 6},
+		{"usdc", 6},
+		{DAI, 18},
+	}
+	for index, test := range testCases {
+		fraction, err := GetCurrencyPrecision(test.currency)
+		if err != nil {
+			t.Fatalf("Error GetCurrencyPrecision at index: %d, err: %v", index, err)
+		}
+		if fraction != test.expected {
+			t.Fatalf("Error at index: %d, expected: %d, got: %d", index, test.expected, fraction)
+		}
+		if IsISOCurrency(test.currency) {
+			t.Fatalf("Error at index: %d, %s must not be an ISO currency", index, test.currency)
+		}
+	}
+}
+
+func TestCryptoMoney(t *testing.T) {
+	wei := decimal.New(1, -18)
+	m, err := NewMoneyFromDecimal(decimal.NewFromInt(2).Add(wei), "eth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.currency != ETH {
+		t.Fatalf("expected ETH, got: %s", m.currency)
+	}
+
+	quantized, err := m.Quantize(Down, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !quantized.Equal(*m) {
+		t.Fatalf("quantizing must keep wei, got: %s", quantized)
+	}
+
+	half, err := m.DivDecimal(decimal.NewFromInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if half.amount.String() != "1.000000000000000001" {
+		t.Fatalf("unexpected half: %s", half)
+	}
+
+	sats, err := NewMoney(0.12345678, BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minor, err := NewMinorMoneyFromMoney(*sats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minor.GetAmount() != 12345678 {
+		t.Fatalf("expected 12345678 satoshis, got: %d", minor.GetAmount())
+	}
+}
+
+func TestGetCurrency(t *testing.T) {
+	c, err := GetCurrency("usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Code != USD || c.Grapheme != "$" || c.Template != "$1" || c.Decimal != "." || c.Thousand != "," {
+		t.Fatalf("unexpected currency: %+v", c)
+	}
+
+	if _, err := GetCurrency("ABC"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestAllCurrencies(t *testing.T) {
+	all := AllCurrencies()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Code >= all[i].Code {
+			t.Fatalf("currencies are not sorted: %s, %s", all[i-1].Code, all[i].Code)
+		}
+	}
+
+	for _, c := range ActiveCurrencies() {
+		if c.Withdrawn != "" {
+			t.Fatalf("%s is withdrawn", c.Code)
+		}
+	}
+	for _, c := range CurrenciesWithFraction(3) {
+		if c.Fraction != 3 {
+			t.Fatalf("unexpected fraction of %s: %d", c.Code, c.Fraction)
+		}
+	}
+
+	metals := MetalCurrencies()
+	if len(metals) != 4 || metals[0].Code != XAG || metals[1].Code != XAU {
+		t.Fatalf("unexpected metals: %v", metals)
+	}
+
+	special := false
+	for _, c := range SpecialCurrencies() {
+		special = special || c.Code == XDR
+	}
+	if !special {
+		t.Fatal("XDR must be a special currency")
+	}
+
+	for _, c := range FundCurrencies() {
+		if c.Code == CLF {
+			return
+		}
+	}
+	t.Fatal("CLF must be a fund currency")
+}
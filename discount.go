@@ -4,16 +4,63 @@ import "github.com/site-name/decimal"
 
 // FixedDiscount applys a fixed discount to any price type.
 func FixedDiscount[K MoneyObject, T MoneyInterface[K]](base T, discount Money) (*K, error) {
-	return base.fixedDiscount(discount)
+	return ptr(base.fixedDiscount(discount))
+}
+
+// MustFixedDiscount is like FixedDiscount but returns a value and panics if error occurs.
+func MustFixedDiscount[K MoneyObject, T MoneyInterface[K]](base T, discount Money) K {
+	return must(base.fixedDiscount(discount))
 }
 
 // FractionalDiscount Apply a fractional discount based on either gross or net amount
 func FractionalDiscount[K MoneyObject, T MoneyInterface[K]](base T, fraction decimal.Decimal, fromGross bool, rounding Rounding) (*K, error) {
-	return base.fractionalDiscount(fraction, fromGross, rounding)
+	return ptr(base.fractionalDiscount(fraction, fromGross, rounding))
+}
+
+// MustFractionalDiscount is like FractionalDiscount but returns a value and panics if error occurs.
+func MustFractionalDiscount[K MoneyObject, T MoneyInterface[K]](base T, fraction decimal.Decimal, fromGross bool, rounding Rounding) K {
+	return must(base.fractionalDiscount(fraction, fromGross, rounding))
 }
 
 // PercentageDiscount Apply a percentage discount based on either gross or net amount.
 func PercentageDiscount[K MoneyObject, T MoneyInterface[K]](base T, percentage float64, fromGross bool, rounding Rounding) (*K, error) {
-	factor := decimal.NewFromFloat(percentage).Div(decimal.NewFromFloat(100))
-	return base.fractionalDiscount(factor, fromGross, rounding)
+	factor := percentageToFraction(percentage)
+	return ptr(base.fractionalDiscount(factor, fromGross, rounding))
+}
+
+// MustPercentageDiscount is like PercentageDiscount but returns a value and panics if error occurs.
+func MustPercentageDiscount[K MoneyObject, T MoneyInterface[K]](base T, percentage float64, fromGross bool, rounding Rounding) K {
+	factor := percentageToFraction(percentage)
+	return must(base.fractionalDiscount(factor, fromGross, rounding))
+}
+
+// CappedFractionalDiscount Apply a fractional discount based on either gross or net amount.
+// The discounted amount never exceeds max, e.g: 20% off, max $15.
+//
+// NOTE: applied to ranges, both ends may collapse to a single value. The result is then a degenerate range.
+func CappedFractionalDiscount[K MoneyObject, T MoneyInterface[K]](base T, fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (*K, error) {
+	return ptr(base.cappedFractionalDiscount(fraction, max, fromGross, rounding))
+}
+
+// MustCappedFractionalDiscount is like CappedFractionalDiscount but returns a value and panics if error occurs.
+func MustCappedFractionalDiscount[K MoneyObject, T MoneyInterface[K]](base T, fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) K {
+	return must(base.cappedFractionalDiscount(fraction, max, fromGross, rounding))
+}
+
+// CappedPercentageDiscount Apply a percentage discount based on either gross or net amount.
+// The discounted amount never exceeds max.
+func CappedPercentageDiscount[K MoneyObject, T MoneyInterface[K]](base T, percentage float64, max Money, fromGross bool, rounding Rounding) (*K, error) {
+	factor := percentageToFraction(percentage)
+	return ptr(base.cappedFractionalDiscount(factor, max, fromGross, rounding))
+}
+
+// MustCappedPercentageDiscount is like CappedPercentageDiscount but returns a value and panics if error occurs.
+func MustCappedPercentageDiscount[K MoneyObject, T MoneyInterface[K]](base T, percentage float64, max Money, fromGross bool, rounding Rounding) K {
+	factor := percentageToFraction(percentage)
+	return must(base.cappedFractionalDiscount(factor, max, fromGross, rounding))
+}
+
+// percentageToFraction converts given percentage to an exact decimal fraction, e.g: 12.5 => 0.125
+func percentageToFraction(percentage float64) decimal.Decimal {
+	return decimal.NewFromFloat(percentage).Shift(-2)
 }
@@ -1,6 +1,7 @@
 package goprices
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -62,3 +63,92 @@ func TestPercentageDiscount(t *testing.T) {
 
 	fmt.Println(vl)
 }
+
+func TestCappedFractionalDiscount(t *testing.T) {
+	max, err := NewMoney(15, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mRange, err := NewMoneyRangeFromFloats(50, 100, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := CappedPercentageDiscount(mRange, 20, *max, false, Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.start.amount.Equal(decimal.NewFromInt(40)) || !res.stop.amount.Equal(decimal.NewFromInt(85)) {
+		t.Fatalf("unexpected discounted range: %s", res)
+	}
+
+	// both ends collapse to a single value
+	mRange, err = NewMoneyRangeFromFloats(10, 12, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = CappedFractionalDiscount(mRange, decimal.NewFromFloat(1), *max, false, Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsDegenerate() {
+		t.Fatalf("expected a degenerate range, got: %s", res)
+	}
+
+	taxed, err := NewTaxedMoneyFromFloats(100, 120, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	taxedRes, err := CappedPercentageDiscount(taxed, 20, *max, true, Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !taxedRes.gross.amount.Equal(decimal.NewFromInt(105)) {
+		t.Fatalf("unexpected discounted taxed money: %s", taxedRes)
+	}
+
+	eur, err := NewMoney(100, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = CappedPercentageDiscount(eur, 20, *max, false, Up)
+	if !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
+
+func TestFractionalDiscountExact(t *testing.T) {
+	m, err := NewMoneyFromDecimal(decimal.RequireFromString("100000000000000000000"), USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := FractionalDiscount(m, decimal.RequireFromString("0.12345678901234567891"), false, Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := decimal.RequireFromString("87654321098765432109"); !res.amount.Equal(expected) {
+		t.Fatalf("expected: %s, got: %s", expected, res.amount)
+	}
+}
+
+func BenchmarkFractionalDiscount(b *testing.B) {
+	m, _ := NewTaxedMoneyFromFloats(100, 120, USD)
+	fraction := decimal.NewFromFloat(0.2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = FractionalDiscount(m, fraction, true, Up)
+	}
+}
+
+func BenchmarkMustFractionalDiscount(b *testing.B) {
+	m, _ := NewTaxedMoneyFromFloats(100, 120, USD)
+	fraction := decimal.NewFromFloat(0.2)
+	value := *m
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = MustFractionalDiscount[TaxedMoney](value, fraction, true, Up)
+	}
+}
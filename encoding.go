@@ -0,0 +1,452 @@
+package goprices
+
+import (
+	"encoding"
+	"encoding/binary"
+	"math/big"
+	"strings"
+
+	"github.com/site-name/decimal"
+)
+
+var (
+	_ encoding.TextMarshaler     = Money{}
+	_ encoding.TextUnmarshaler   = (*Money)(nil)
+	_ encoding.BinaryMarshaler   = Money{}
+	_ encoding.BinaryUnmarshaler = (*Money)(nil)
+)
+
+// binaryVersion is the version of the binary encoding, it is the first byte of every encoded value
+const binaryVersion byte = 1
+
+// binaryKind is the second byte of every encoded value, so a value can not be decoded as another type
+type binaryKind byte
+
+const (
+	moneyKind binaryKind = iota + 1
+	taxedMoneyKind
+	moneyRangeKind
+	taxedMoneyRangeKind
+)
+
+// formatAmount formats amount of given money with currency precision, unless it would lose digits
+func formatAmount(m Money) string {
+	precision, err := GetCurrencyPrecision(m.currency)
+	if err == nil && m.amount.Round(int32(precision)).Equal(m.amount) {
+		return m.amount.StringFixed(int32(precision))
+	}
+	return m.amount.String()
+}
+
+// splitCurrency splits text like "USD 12.50" into its validated currency and the rest
+func splitCurrency(text []byte) (string, string, error) {
+	currency, rest, ok := strings.Cut(string(text), " ")
+	if !ok || rest == "" {
+		return "", "", ErrInvalidEncoding
+	}
+	unit, err := validateCurrency(currency)
+	if err != nil {
+		return "", "", err
+	}
+	return unit, rest, nil
+}
+
+func parseAmount(text string) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(text)
+	if err != nil {
+		return decimal.Zero, ErrInvalidEncoding
+	}
+	return amount, nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface, amount is formatted with currency precision
+// when it has no more decimal places, e.g:
+//
+//	USD 12.50
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.currency + " " + formatAmount(m)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. Negative amounts are accepted.
+//
+// Returned error could be `nil`, `ErrInvalidEncoding` or an *UnknownCurrencyError
+func (m *Money) UnmarshalText(text []byte) error {
+	unit, rest, err := splitCurrency(text)
+	if err != nil {
+		return err
+	}
+	amount, err := parseAmount(rest)
+	if err != nil {
+		return err
+	}
+	*m = Money{amount, unit}
+	return nil
+}
+
+func (t TaxedMoney) formatAmounts() string {
+	return formatAmount(t.net) + "/" + formatAmount(t.gross)
+}
+
+func parseTaxedAmounts(text, currency string) (TaxedMoney, error) {
+	netText, grossText, ok := strings.Cut(text, "/")
+	if !ok {
+		return TaxedMoney{}, ErrInvalidEncoding
+	}
+	net, err := parseAmount(netText)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	gross, err := parseAmount(grossText)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	return TaxedMoney{Money{net, currency}, Money{gross, currency}}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface, formatting net and gross, e.g:
+//
+//	USD 10.00/12.30
+func (t TaxedMoney) MarshalText() ([]byte, error) {
+	return []byte(t.GetCurrency() + " " + t.formatAmounts()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. See TaxedMoney.MarshalText
+func (t *TaxedMoney) UnmarshalText(text []byte) error {
+	unit, rest, err := splitCurrency(text)
+	if err != nil {
+		return err
+	}
+	res, err := parseTaxedAmounts(rest, unit)
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
+// formatRangeText formats a range with interval notation, e.g: "USD [20.00,+inf)"
+func formatRangeText[T any](currency string, start, stop rangeEnd[T], format func(T) string) []byte {
+	startText, stopText := "-inf", "+inf"
+	if start.bound != Unbounded {
+		startText = format(start.value)
+	}
+	if stop.bound != Unbounded {
+		stopText = format(stop.value)
+	}
+	return []byte(currency + " " + start.bound.startBracket() + startText + "," + stopText + stop.bound.stopBracket())
+}
+
+// parseRangeText parses a range formatted by formatRangeText, values of unbounded ends are zero
+func parseRangeText[T any](text []byte, zero func(currency string) T, parse func(text, currency string) (T, error)) (start, stop rangeEnd[T], err error) {
+	unit, rest, err := splitCurrency(text)
+	if err != nil {
+		return start, stop, err
+	}
+	if len(rest) < 2 {
+		return start, stop, ErrInvalidEncoding
+	}
+	startText, stopText, ok := strings.Cut(rest[1:len(rest)-1], ",")
+	if !ok {
+		return start, stop, ErrInvalidEncoding
+	}
+
+	switch rest[0] {
+	case '[':
+		start.bound = Inclusive
+	case '(':
+		start.bound = Exclusive
+	default:
+		return start, stop, ErrInvalidEncoding
+	}
+	switch rest[len(rest)-1] {
+	case ']':
+		stop.bound = Inclusive
+	case ')':
+		stop.bound = Exclusive
+	default:
+		return start, stop, ErrInvalidEncoding
+	}
+
+	if startText == "-inf" {
+		start.bound, start.value = Unbounded, zero(unit)
+	} else if start.value, err = parse(startText, unit); err != nil {
+		return start, stop, err
+	}
+	if stopText == "+inf" {
+		stop.bound, stop.value = Unbounded, zero(unit)
+	} else if stop.value, err = parse(stopText, unit); err != nil {
+		return start, stop, err
+	}
+	return start, stop, nil
+}
+
+func parseMoneyText(text, currency string) (Money, error) {
+	amount, err := parseAmount(text)
+	return Money{amount, currency}, err
+}
+
+// MarshalText implements encoding.TextMarshaler interface using interval notation, e.g:
+//
+//	USD [20.00,100.00)
+//	USD (-inf,100.00]
+func (m MoneyRange) MarshalText() ([]byte, error) {
+	return formatRangeText(m.GetCurrency(), m.startEnd(), m.stopEnd(), formatAmount), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. Negative ends are accepted.
+//
+// Returned error could be `nil`, `ErrInvalidEncoding`, an *UnknownCurrencyError or an *InvalidRangeError
+func (m *MoneyRange) UnmarshalText(text []byte) error {
+	start, stop, err := parseRangeText(text, zeroOf[Money], parseMoneyText)
+	if err != nil {
+		return err
+	}
+	res, err := newMoneyRange(start.value, stop.value, start.bound, stop.bound)
+	if err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface using interval notation, e.g:
+//
+//	USD [10.00/12.30,20.00/24.60)
+func (t TaxedMoneyRange) MarshalText() ([]byte, error) {
+	return formatRangeText(t.GetCurrency(), t.startEnd(), t.stopEnd(), TaxedMoney.formatAmounts), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. See MoneyRange.UnmarshalText
+func (t *TaxedMoneyRange) UnmarshalText(text []byte) error {
+	start, stop, err := parseRangeText(text, zeroOf[TaxedMoney], parseTaxedAmounts)
+	if err != nil {
+		return err
+	}
+	res, err := newTaxedMoneyRange(start.value, stop.value, start.bound, stop.bound)
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
+// binaryHeader returns version, kind and currency of an encoded value
+func binaryHeader(kind binaryKind, currency string) []byte {
+	buf := make([]byte, 0, 3+len(currency)+16)
+	buf = append(buf, binaryVersion, byte(kind), byte(len(currency)))
+	return append(buf, currency...)
+}
+
+// appendAmount appends exponent and coefficient of given amount to buf
+func appendAmount(buf []byte, amount decimal.Decimal) []byte {
+	buf = binary.AppendVarint(buf, int64(amount.Exponent()))
+	coefficient := amount.Coefficient()
+	sign := byte(0)
+	if coefficient.Sign() < 0 {
+		sign = 1
+	}
+	magnitude := coefficient.Bytes()
+	buf = append(buf, sign)
+	buf = binary.AppendUvarint(buf, uint64(len(magnitude)))
+	return append(buf, magnitude...)
+}
+
+// binaryReader decodes values encoded by binaryHeader and appendAmount
+type binaryReader struct {
+	data []byte
+	err  error
+}
+
+// header reads version, kind and currency
+func (r *binaryReader) header(kind binaryKind) string {
+	if len(r.data) < 3 || r.data[0] != binaryVersion || binaryKind(r.data[1]) != kind {
+		r.err = ErrInvalidEncoding
+		return ""
+	}
+	size := int(r.data[2])
+	r.data = r.data[3:]
+	if len(r.data) < size {
+		r.err = ErrInvalidEncoding
+		return ""
+	}
+	currency := string(r.data[:size])
+	r.data = r.data[size:]
+
+	unit, err := validateCurrency(currency)
+	if err != nil {
+		r.err = err
+	}
+	return unit
+}
+
+func (r *binaryReader) byte() byte {
+	if r.err != nil || len(r.data) == 0 {
+		r.err = ErrInvalidEncoding
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+func (r *binaryReader) amount() decimal.Decimal {
+	if r.err != nil {
+		return decimal.Zero
+	}
+	exp, n := binary.Varint(r.data)
+	if n <= 0 || int64(int32(exp)) != exp {
+		r.err = ErrInvalidEncoding
+		return decimal.Zero
+	}
+	r.data = r.data[n:]
+
+	sign := r.byte()
+	if r.err != nil {
+		return decimal.Zero
+	}
+	if sign > 1 {
+		r.err = ErrInvalidEncoding
+		return decimal.Zero
+	}
+	size, n := binary.Uvarint(r.data)
+	if n <= 0 || size > uint64(len(r.data)-n) {
+		r.err = ErrInvalidEncoding
+		return decimal.Zero
+	}
+	coefficient := new(big.Int).SetBytes(r.data[n : n+int(size)])
+	r.data = r.data[n+int(size):]
+	if sign == 1 {
+		coefficient.Neg(coefficient)
+	}
+	return decimal.NewFromBigInt(coefficient, int32(exp))
+}
+
+// done returns reading error, or ErrInvalidEncoding if some data was not read
+func (r *binaryReader) done() error {
+	if r.err == nil && len(r.data) != 0 {
+		return ErrInvalidEncoding
+	}
+	return r.err
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface, it is used by encoding/gob too.
+// The encoding is versioned, decoding then encoding it again gives the same bytes.
+func (m Money) MarshalBinary() ([]byte, error) {
+	return appendAmount(binaryHeader(moneyKind, m.currency), m.amount), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface.
+//
+// Returned error could be `nil`, `ErrInvalidEncoding` or an *UnknownCurrencyError
+func (m *Money) UnmarshalBinary(data []byte) error {
+	r := binaryReader{data: data}
+	unit := r.header(moneyKind)
+	amount := r.amount()
+	if err := r.done(); err != nil {
+		return err
+	}
+	*m = Money{amount, unit}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface. See Money.MarshalBinary
+func (t TaxedMoney) MarshalBinary() ([]byte, error) {
+	buf := binaryHeader(taxedMoneyKind, t.GetCurrency())
+	buf = appendAmount(buf, t.net.amount)
+	return appendAmount(buf, t.gross.amount), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface. See Money.UnmarshalBinary
+func (t *TaxedMoney) UnmarshalBinary(data []byte) error {
+	r := binaryReader{data: data}
+	unit := r.header(taxedMoneyKind)
+	net, gross := r.amount(), r.amount()
+	if err := r.done(); err != nil {
+		return err
+	}
+	*t = TaxedMoney{Money{net, unit}, Money{gross, unit}}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface, only bounded ends are encoded.
+// See Money.MarshalBinary
+func (m MoneyRange) MarshalBinary() ([]byte, error) {
+	buf := binaryHeader(moneyRangeKind, m.GetCurrency())
+	buf = append(buf, byte(m.startBound)<<4|byte(m.stopBound))
+	if m.startBound != Unbounded {
+		buf = appendAmount(buf, m.start.amount)
+	}
+	if m.stopBound != Unbounded {
+		buf = appendAmount(buf, m.stop.amount)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface.
+//
+// Returned error could be `nil`, `ErrInvalidEncoding`, `ErrInvalidBound`, an *UnknownCurrencyError or an *InvalidRangeError
+func (m *MoneyRange) UnmarshalBinary(data []byte) error {
+	r := binaryReader{data: data}
+	unit := r.header(moneyRangeKind)
+	bounds := r.byte()
+	startBound, stopBound := Bound(bounds>>4), Bound(bounds&0x0f)
+
+	start, stop := zeroOf[Money](unit), zeroOf[Money](unit)
+	if startBound != Unbounded {
+		start.amount = r.amount()
+	}
+	if stopBound != Unbounded {
+		stop.amount = r.amount()
+	}
+	if err := r.done(); err != nil {
+		return err
+	}
+
+	res, err := newMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface. See MoneyRange.MarshalBinary
+func (t TaxedMoneyRange) MarshalBinary() ([]byte, error) {
+	buf := binaryHeader(taxedMoneyRangeKind, t.GetCurrency())
+	buf = append(buf, byte(t.startBound)<<4|byte(t.stopBound))
+	if t.startBound != Unbounded {
+		buf = appendAmount(buf, t.start.net.amount)
+		buf = appendAmount(buf, t.start.gross.amount)
+	}
+	if t.stopBound != Unbounded {
+		buf = appendAmount(buf, t.stop.net.amount)
+		buf = appendAmount(buf, t.stop.gross.amount)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface. See MoneyRange.UnmarshalBinary
+func (t *TaxedMoneyRange) UnmarshalBinary(data []byte) error {
+	r := binaryReader{data: data}
+	unit := r.header(taxedMoneyRangeKind)
+	bounds := r.byte()
+	startBound, stopBound := Bound(bounds>>4), Bound(bounds&0x0f)
+
+	start, stop := zeroOf[TaxedMoney](unit), zeroOf[TaxedMoney](unit)
+	if startBound != Unbounded {
+		start.net.amount, start.gross.amount = r.amount(), r.amount()
+	}
+	if stopBound != Unbounded {
+		stop.net.amount, stop.gross.amount = r.amount(), r.amount()
+	}
+	if err := r.done(); err != nil {
+		return err
+	}
+
+	res, err := newTaxedMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
@@ -0,0 +1,223 @@
+package goprices
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoneyText(t *testing.T) {
+	type testUnit struct {
+		money    Money
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{*must(NewMoney(12.5, USD)), "USD 12.50"},
+		{*must(NewMoney(12.345, USD)), "USD 12.345"},
+		{*must(NewSignedMoney(-3, JPY)), "JPY -3"},
+		{*must(NewMoney(0, BHD)), "BHD 0.000"},
+	} {
+		text, err := unit.money.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, text)
+		}
+
+		var decoded Money
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(unit.money) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.money, decoded)
+		}
+	}
+
+	var decoded Money
+	for index, text := range []string{"", "USD", "USD ", "USD 1.2.3", "12.50 USD"} {
+		if err := decoded.UnmarshalText([]byte(text)); err == nil {
+			t.Fatalf("Error at index: %d, expected an error for %q", index, text)
+		}
+	}
+	if err := decoded.UnmarshalText([]byte("ABC 1")); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestRangeText(t *testing.T) {
+	type testUnit struct {
+		value    encoding.TextMarshaler
+		decoded  encoding.TextUnmarshaler
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{*must(NewTaxedMoneyFromFloats(10, 12.3, USD)), new(TaxedMoney), "USD 10.00/12.30"},
+		{*must(NewMoneyRange(*must(NewMoney(20, USD)), *must(NewMoney(100, USD)))), new(MoneyRange), "USD [20.00,100.00]"},
+		{*must(NewMoneyRangeFrom(*must(NewMoney(20, USD)), Exclusive)), new(MoneyRange), "USD (20.00,+inf)"},
+		{*must(NewMoneyRangeTo(*must(NewMoney(5, EUR)), Exclusive)), new(MoneyRange), "EUR (-inf,5.00)"},
+		{
+			*must(NewTaxedMoneyRangeWithBounds(
+				*must(NewTaxedMoneyFromFloats(10, 12.3, USD)), Inclusive,
+				*must(NewTaxedMoneyFromFloats(20, 24.6, USD)), Exclusive,
+			)),
+			new(TaxedMoneyRange),
+			"USD [10.00/12.30,20.00/24.60)",
+		},
+	} {
+		text, err := unit.value.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, text)
+		}
+		if err := unit.decoded.UnmarshalText(text); err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		again, err := unit.decoded.(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, text) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, text, again)
+		}
+	}
+
+	type errUnit struct {
+		text string
+		err  error
+	}
+	var decoded MoneyRange
+	for index, unit := range []errUnit{
+		{"USD [1,2", ErrInvalidEncoding},
+		{"USD {1,2]", ErrInvalidEncoding},
+		{"USD [1;2]", ErrInvalidEncoding},
+		{"USD [+inf,2]", ErrInvalidEncoding},
+		{"USD [2,1]", ErrStopLessThanStart},
+		{"ABC [1,2]", ErrUnknownCurrency},
+	} {
+		if err := decoded.UnmarshalText([]byte(unit.text)); !errors.Is(err, unit.err) {
+			t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+		}
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	type testUnit struct {
+		value   encoding.BinaryMarshaler
+		decoded encoding.BinaryUnmarshaler
+	}
+	for index, unit := range []testUnit{
+		{*must(NewMoney(12.5, USD)), new(Money)},
+		{*must(NewSignedMoney(-0.001, BHD)), new(Money)},
+		{*must(NewTaxedMoneyFromFloats(10, 12.3, EUR)), new(TaxedMoney)},
+		{*must(NewMoneyRangeFrom(*must(NewMoney(20, USD)), Exclusive)), new(MoneyRange)},
+		{*must(NewMoneyRange(*must(NewMoney(1, USD)), *must(NewMoney(2, USD)))), new(MoneyRange)},
+		{
+			*must(NewTaxedMoneyRangeWithBounds(
+				*must(NewTaxedMoneyFromFloats(10, 12.3, USD)), Exclusive,
+				*must(NewTaxedMoneyFromFloats(20, 24.6, USD)), Unbounded,
+			)),
+			new(TaxedMoneyRange),
+		},
+	} {
+		data, err := unit.value.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := unit.decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		again, err := unit.decoded.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, data) {
+			t.Fatalf("Error at index: %d, expected: %x, got: %x", index, data, again)
+		}
+		// decoding a truncated value must fail
+		if err := unit.decoded.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("Error at index: %d, expected ErrInvalidEncoding, got: %v", index, err)
+		}
+	}
+
+	data, err := must(NewMoney(1, USD)).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var taxed TaxedMoney
+	if err := taxed.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("expected ErrInvalidEncoding for another kind, got: %v", err)
+	}
+	data[0] = binaryVersion + 1
+	var money Money
+	if err := money.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("expected ErrInvalidEncoding for another version, got: %v", err)
+	}
+
+	// 1 USD ends with sign, coefficient size and coefficient bytes
+	data, err = must(NewMoney(1, USD)).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sign := range []byte{2, 0xff} {
+		data[len(data)-3] = sign
+		if err := money.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("expected ErrInvalidEncoding for sign %d, got: %v", sign, err)
+		}
+	}
+	data[len(data)-3] = 1
+	if err := money.UnmarshalBinary(data); err != nil || money.String() != "Money{-1, USD}" {
+		t.Fatalf("expected Money{-1, USD}, got: %s, %v", money, err)
+	}
+}
+
+func TestGob(t *testing.T) {
+	type order struct {
+		Total TaxedMoney
+		Range MoneyRange
+	}
+	value := order{
+		Total: *must(NewTaxedMoneyFromFloats(10, 12.3, USD)),
+		Range: *must(NewMoneyRangeTo(*must(NewMoney(50, USD)), Exclusive)),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+		t.Fatal(err)
+	}
+	var decoded order
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Total.Equal(value.Total) || !decoded.Range.Equal(value.Range) {
+		t.Fatalf("expected: %v, got: %v", value, decoded)
+	}
+}
+
+func TestTextMapKeys(t *testing.T) {
+	var counts map[MoneyRange]int
+	if err := json.Unmarshal([]byte(`{"USD [0.00,10.00)":3,"USD [10.00,+inf)":1}`), &counts); err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 {
+		t.Fatalf("expected 2 keys, got: %v", counts)
+	}
+	for key, count := range counts {
+		if key.IsBounded() && count != 3 || !key.IsBounded() && count != 1 {
+			t.Fatalf("unexpected count %d of %s", count, key)
+		}
+	}
+
+	data, err := json.Marshal(map[Money]int{*must(NewMoney(1, USD)): 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"USD 1.00":2}` {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+}
@@ -0,0 +1,85 @@
+package goprices
+
+import (
+	"fmt"
+	"time"
+)
+
+// CurrencyMismatchError is returned when performing operations between money with different currencies.
+// It matches ErrNotSameCurrency with errors.Is
+type CurrencyMismatchError struct {
+	Left  string
+	Right string
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s and %s", ErrNotSameCurrency, e.Left, e.Right)
+}
+
+func (e *CurrencyMismatchError) Is(target error) bool {
+	return target == ErrNotSameCurrency
+}
+
+// UnknownCurrencyError is returned when given currency code is invalid.
+// It matches ErrUnknownCurrency with errors.Is
+type UnknownCurrencyError struct {
+	Code string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("%s: %q", ErrUnknownCurrency, e.Code)
+}
+
+func (e *UnknownCurrencyError) Is(target error) bool {
+	return target == ErrUnknownCurrency
+}
+
+// NoCurrencyError is returned when no known currency was used as legal tender in a region at a given time.
+// It matches ErrNoCurrency with errors.Is
+type NoCurrencyError struct {
+	Region string
+	At     time.Time
+}
+
+func (e *NoCurrencyError) Error() string {
+	return fmt.Sprintf("%s: region %q at %s", ErrNoCurrency, e.Region, e.At.Format("2006-01-02"))
+}
+
+func (e *NoCurrencyError) Is(target error) bool {
+	return target == ErrNoCurrency
+}
+
+// InvalidRangeError is returned when stop of a range is less than its start.
+// Start and Stop are either Money or TaxedMoney.
+// It matches ErrStopLessThanStart with errors.Is
+type InvalidRangeError struct {
+	Start fmt.Stringer
+	Stop  fmt.Stringer
+}
+
+func (e *InvalidRangeError) Error() string {
+	return fmt.Sprintf("%s: start=%s, stop=%s", ErrStopLessThanStart, e.Start, e.Stop)
+}
+
+func (e *InvalidRangeError) Is(target error) bool {
+	return target == ErrStopLessThanStart
+}
+
+// RowError is returned when a row of a price list is invalid, Err tells why.
+// Reading can continue with the next row.
+type RowError struct {
+	Line   int    // Line of the row in the file, starting at 1
+	Column string // Column is the name of the invalid column, empty if the whole row is invalid
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %q: %s", e.Line, e.Column, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
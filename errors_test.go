@@ -0,0 +1,59 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	usd, err := NewMoney(10, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eur, err := NewMoney(10, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = usd.Add(*eur)
+	if !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+	var mismatch *CurrencyMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Left != USD || mismatch.Right != EUR {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+
+	_, err = NewTaxedMoney(*usd, *eur)
+	if !errors.As(err, &mismatch) || mismatch.Left != USD || mismatch.Right != EUR {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+}
+
+func TestUnknownCurrencyError(t *testing.T) {
+	_, err := NewMoney(10, "abc")
+	if !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+	var unknown *UnknownCurrencyError
+	if !errors.As(err, &unknown) || unknown.Code != "abc" {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if err.Error() != `unknown currency unit: "abc"` {
+		t.Fatalf("unexpected message: %s", err)
+	}
+}
+
+func TestInvalidRangeError(t *testing.T) {
+	_, err := NewMoneyRangeFromFloats(20, 10, USD)
+	if !errors.Is(err, ErrStopLessThanStart) {
+		t.Fatalf("expected ErrStopLessThanStart, got: %v", err)
+	}
+	var invalid *InvalidRangeError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if start, ok := invalid.Start.(Money); !ok || start.amount.IntPart() != 20 {
+		t.Fatalf("unexpected start: %v", invalid.Start)
+	}
+}
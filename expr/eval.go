@@ -0,0 +1,364 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+// value is either money or a number
+type value struct {
+	isMoney bool
+	money   goprices.Money
+	number  decimal.Decimal
+}
+
+func (v value) kind() string {
+	if v.isMoney {
+		return "money"
+	}
+	return "number"
+}
+
+func moneyValue(m goprices.Money) value {
+	return value{isMoney: true, money: m}
+}
+
+func numberValue(d decimal.Decimal) value {
+	return value{number: d}
+}
+
+// Eval evaluates current expression with given variables.
+//
+// Returned error could be `nil` or an *Error wrapping ErrType, ErrUnknownVariable, ErrNotMoney,
+// a *goprices.CurrencyMismatchError or goprices.ErrDivisorZero
+//
+// E.g:
+//
+//	MustParse("max(cost * 1.3, 10 EUR)").Eval(map[string]goprices.Money{"cost": 5 EUR}) => 10 EUR
+func (e *Expr) Eval(vars map[string]goprices.Money) (goprices.Money, error) {
+	v, err := eval(e.root, vars)
+	if err != nil {
+		return goprices.Money{}, err
+	}
+	if !v.isMoney {
+		return goprices.Money{}, &Error{Pos: 0, Err: ErrNotMoney}
+	}
+	return v.money, nil
+}
+
+func typeError(n node, format string, args ...any) error {
+	return &Error{Pos: n.pos(), Err: fmt.Errorf("%w: "+format, append([]any{ErrType}, args...)...)}
+}
+
+func eval(n node, vars map[string]goprices.Money) (value, error) {
+	switch n := n.(type) {
+	case *number:
+		return numberValue(n.value), nil
+
+	case *money:
+		return moneyValue(n.value), nil
+
+	case *variable:
+		m, ok := vars[n.name]
+		if !ok {
+			return value{}, &Error{Pos: n.p, Err: fmt.Errorf("%w: %s", ErrUnknownVariable, n.name)}
+		}
+		return moneyValue(m), nil
+
+	case *unary:
+		x, err := eval(n.x, vars)
+		if err != nil {
+			return value{}, err
+		}
+		if x.isMoney {
+			return moneyValue(x.money.Neg()), nil
+		}
+		return numberValue(x.number.Neg()), nil
+
+	case *binary:
+		x, err := eval(n.x, vars)
+		if err != nil {
+			return value{}, err
+		}
+		y, err := eval(n.y, vars)
+		if err != nil {
+			return value{}, err
+		}
+		res, err := evalBinary(n.op, x, y)
+		if err != nil {
+			return value{}, wrap(n, err)
+		}
+		return res, nil
+
+	case *call:
+		args := make([]value, len(n.args))
+		for i, arg := range n.args {
+			v, err := eval(arg, vars)
+			if err != nil {
+				return value{}, err
+			}
+			args[i] = v
+		}
+		res, err := evalCall(n.name, args)
+		if err != nil {
+			return value{}, wrap(n, err)
+		}
+		return res, nil
+	}
+	panic("expr: unknown node")
+}
+
+// wrap returns given error as an *Error at position of n, unless it already is one
+func wrap(n node, err error) error {
+	if _, ok := err.(*Error); ok {
+		return err
+	}
+	return &Error{Pos: n.pos(), Err: err}
+}
+
+func evalBinary(op byte, x, y value) (value, error) {
+	switch {
+	case op == '+' || op == '-':
+		if x.isMoney != y.isMoney {
+			return value{}, fmt.Errorf("%w: can not use %c between %s and %s", ErrType, op, x.kind(), y.kind())
+		}
+		if !x.isMoney {
+			if op == '+' {
+				return numberValue(x.number.Add(y.number)), nil
+			}
+			return numberValue(x.number.Sub(y.number)), nil
+		}
+		var m *goprices.Money
+		var err error
+		if op == '+' {
+			m, err = x.money.Add(y.money)
+		} else {
+			m, err = x.money.Sub(y.money)
+		}
+		if err != nil {
+			return value{}, err
+		}
+		return moneyValue(*m), nil
+
+	case op == '*':
+		switch {
+		case x.isMoney && y.isMoney:
+			return value{}, fmt.Errorf("%w: can not multiply money by money", ErrType)
+		case x.isMoney:
+			return moneyValue(x.money.MulDecimal(y.number)), nil
+		case y.isMoney:
+			return moneyValue(y.money.MulDecimal(x.number)), nil
+		}
+		return numberValue(x.number.Mul(y.number)), nil
+
+	default:
+		switch {
+		case !x.isMoney && y.isMoney:
+			return value{}, fmt.Errorf("%w: can not divide a number by money", ErrType)
+		case x.isMoney && y.isMoney:
+			if !x.money.SameKind(y.money) {
+				return value{}, &goprices.CurrencyMismatchError{Left: x.money.GetCurrency(), Right: y.money.GetCurrency()}
+			}
+			if y.money.IsZero() {
+				return value{}, goprices.ErrDivisorZero
+			}
+			return numberValue(x.money.GetAmount().Div(y.money.GetAmount())), nil
+		case x.isMoney:
+			m, err := x.money.DivDecimal(y.number)
+			if err != nil {
+				return value{}, err
+			}
+			return moneyValue(*m), nil
+		}
+		if y.number.IsZero() {
+			return value{}, goprices.ErrDivisorZero
+		}
+		return numberValue(x.number.Div(y.number)), nil
+	}
+}
+
+// maxRoundPlaces is the maximum number of decimal places given to round
+const maxRoundPlaces = 28
+
+// validPlaces checks if d is a number of decimal places accepted by round
+func validPlaces(d decimal.Decimal) bool {
+	return d.IsInteger() && !d.IsNegative() && d.LessThanOrEqual(decimal.NewFromInt(maxRoundPlaces))
+}
+
+func evalCall(name string, args []value) (value, error) {
+	switch name {
+	case "min", "max":
+		for _, arg := range args[1:] {
+			if arg.isMoney != args[0].isMoney {
+				return value{}, fmt.Errorf("%w: %s of %s and %s", ErrType, name, args[0].kind(), arg.kind())
+			}
+		}
+		if args[0].isMoney {
+			values := make([]goprices.Money, len(args))
+			for i, arg := range args {
+				values[i] = arg.money
+			}
+			pick := goprices.Min
+			if name == "max" {
+				pick = goprices.Max
+			}
+			m, err := pick(values...)
+			if err != nil {
+				return value{}, err
+			}
+			return moneyValue(*m), nil
+		}
+		res := args[0].number
+		for _, arg := range args[1:] {
+			if name == "min" && arg.number.LessThan(res) || name == "max" && arg.number.GreaterThan(res) {
+				res = arg.number
+			}
+		}
+		return numberValue(res), nil
+
+	default: // round
+		x := args[0]
+		places := int32(0)
+		if len(args) == 2 {
+			p := args[1]
+			if p.isMoney || !validPlaces(p.number) {
+				return value{}, fmt.Errorf("%w: round places must be an integer from 0 to %d", ErrType, maxRoundPlaces)
+			}
+			places = int32(p.number.IntPart())
+		} else if x.isMoney {
+			precision, err := goprices.GetCurrencyPrecision(x.money.GetCurrency())
+			if err != nil {
+				return value{}, err
+			}
+			places = int32(precision)
+		}
+		if !x.isMoney {
+			return numberValue(x.number.Round(places)), nil
+		}
+		m := x.money
+		m.SetAmount(m.GetAmount().Round(places))
+		return moneyValue(m), nil
+	}
+}
+
+// Check checks current expression without evaluating it, e.g: when a pricing rule is saved.
+// Variables are given with their currency.
+//
+// Returned error could be `nil` or an *Error wrapping ErrType, ErrUnknownVariable, ErrNotMoney
+// or a *goprices.CurrencyMismatchError
+//
+// E.g:
+//
+//	MustParse("base * 1.2 - 5 USD").Check(map[string]string{"base": "EUR"}) => *Error wrapping *goprices.CurrencyMismatchError
+func (e *Expr) Check(currencies map[string]string) error {
+	v, err := check(e.root, currencies)
+	if err != nil {
+		return err
+	}
+	if !v.isMoney {
+		return &Error{Pos: 0, Err: ErrNotMoney}
+	}
+	return nil
+}
+
+// kind is the type of a checked node, currency is set for money
+type kind struct {
+	isMoney  bool
+	currency string
+}
+
+func (k kind) String() string {
+	if k.isMoney {
+		return "money"
+	}
+	return "number"
+}
+
+func check(n node, currencies map[string]string) (kind, error) {
+	switch n := n.(type) {
+	case *number:
+		return kind{}, nil
+
+	case *money:
+		return kind{true, n.value.GetCurrency()}, nil
+
+	case *variable:
+		currency, ok := currencies[n.name]
+		if !ok {
+			return kind{}, &Error{Pos: n.p, Err: fmt.Errorf("%w: %s", ErrUnknownVariable, n.name)}
+		}
+		return kind{true, currency}, nil
+
+	case *unary:
+		return check(n.x, currencies)
+
+	case *binary:
+		x, err := check(n.x, currencies)
+		if err != nil {
+			return kind{}, err
+		}
+		y, err := check(n.y, currencies)
+		if err != nil {
+			return kind{}, err
+		}
+		switch {
+		case (n.op == '+' || n.op == '-') && x.isMoney != y.isMoney:
+			return kind{}, typeError(n, "can not use %c between %s and %s", n.op, x, y)
+		case n.op == '*' && x.isMoney && y.isMoney:
+			return kind{}, typeError(n, "can not multiply money by money")
+		case n.op == '/' && !x.isMoney && y.isMoney:
+			return kind{}, typeError(n, "can not divide a number by money")
+		case x.isMoney && y.isMoney && n.op != '*':
+			if err := sameCurrency(n, x, y); err != nil {
+				return kind{}, err
+			}
+			if n.op == '/' {
+				return kind{}, nil
+			}
+		}
+		if y.isMoney {
+			return y, nil
+		}
+		return x, nil
+
+	case *call:
+		args := make([]kind, len(n.args))
+		for i, arg := range n.args {
+			k, err := check(arg, currencies)
+			if err != nil {
+				return kind{}, err
+			}
+			args[i] = k
+		}
+		if n.name == "round" {
+			if len(args) == 2 {
+				// places given as a literal are checked here, computed ones when evaluated
+				places, literal := n.args[1].(*number)
+				if args[1].isMoney || (literal && !validPlaces(places.value)) {
+					return kind{}, typeError(n, "round places must be an integer from 0 to %d", maxRoundPlaces)
+				}
+			}
+			return args[0], nil
+		}
+		for _, arg := range args[1:] {
+			if arg.isMoney != args[0].isMoney {
+				return kind{}, typeError(n, "%s of %s and %s", n.name, args[0], arg)
+			}
+			if err := sameCurrency(n, args[0], arg); err != nil {
+				return kind{}, err
+			}
+		}
+		return args[0], nil
+	}
+	panic("expr: unknown node")
+}
+
+func sameCurrency(n node, x, y kind) error {
+	if x.isMoney && y.isMoney && !strings.EqualFold(x.currency, y.currency) {
+		return &Error{Pos: n.pos(), Err: &goprices.CurrencyMismatchError{Left: x.currency, Right: y.currency}}
+	}
+	return nil
+}
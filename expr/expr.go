@@ -0,0 +1,92 @@
+// Package expr parses and evaluates price expressions over goprices.Money, e.g:
+//
+//	base * 1.2 - 5 USD
+//	max(cost * 1.3, 10 EUR)
+//	round(base * (1 - 15%))
+//
+// An expression is made of:
+//   - number literals, e.g: 1.3, and percent literals, e.g: 15% which is 0.15
+//   - money literals, a number followed by a currency code, e.g: 5 USD
+//   - variables, e.g: base, holding money given at evaluation
+//   - operators + - * / and parentheses
+//   - functions min(x, ...), max(x, ...) and round(x), round(x, places)
+//
+// Money can be added to or subtracted from money of the same currency, multiplied or divided by a number.
+// Money divided by money of the same currency is a number. Money division is rounded half up to currency
+// precision, as goprices.Money.DivDecimal does. round rounds half up to currency precision, or to given places (0 to 28).
+//
+// Expressions can be checked once parsed, e.g: when a pricing rule is saved, then evaluated safely.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrSyntax          = errors.New("syntax error")
+	ErrType            = errors.New("type error")
+	ErrUnknownVariable = errors.New("unknown variable")
+	ErrUnknownFunction = errors.New("unknown function")
+	ErrNotMoney        = errors.New("expression result is not money")
+)
+
+// Error is returned when parsing, checking or evaluating an expression fails.
+// Err is either one of the errors of this package or a goprices error, e.g: a *goprices.CurrencyMismatchError,
+// they can be matched with errors.Is and errors.As
+type Error struct {
+	Pos int // Pos is the byte offset of the failing part of the expression
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("expr: at offset %d: %s", e.Pos, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Expr is a parsed expression, it is safe for concurrent use
+type Expr struct {
+	source string
+	root   node
+}
+
+// String returns source of current expression
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Variables returns names of variables used by current expression, sorted
+func (e *Expr) Variables() []string {
+	seen := map[string]bool{}
+	walk(e.root, func(n node) {
+		if v, ok := n.(*variable); ok {
+			seen[v.name] = true
+		}
+	})
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// walk calls fn for n and all of its descendants
+func walk(n node, fn func(node)) {
+	fn(n)
+	switch n := n.(type) {
+	case *unary:
+		walk(n.x, fn)
+	case *binary:
+		walk(n.x, fn)
+		walk(n.y, fn)
+	case *call:
+		for _, arg := range n.args {
+			walk(arg, fn)
+		}
+	}
+}
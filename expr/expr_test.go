@@ -0,0 +1,144 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	goprices "github.com/site-name/go-prices"
+)
+
+func newMoney(amount float64, currency string) goprices.Money {
+	m, err := goprices.NewSignedMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return *m
+}
+
+func TestEval(t *testing.T) {
+	vars := map[string]goprices.Money{
+		"base": newMoney(10, goprices.USD),
+		"cost": newMoney(5, goprices.EUR),
+	}
+
+	type testUnit struct {
+		source   string
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{"base * 1.2 - 5 USD", "Money{7, USD}"},
+		{"max(cost * 1.3, 10 EUR)", "Money{10, EUR}"},
+		{"min(cost * 1.3, 10 EUR)", "Money{6.5, EUR}"},
+		{"round(base * (1 - 15%))", "Money{8.5, USD}"},
+		{"round(base / 3 * 1.005)", "Money{3.35, USD}"},
+		{"round(base * 1.23456, 1)", "Money{12.3, USD}"},
+		{"base / 3", "Money{3.33, USD}"},
+		{"-base + 2 * 3 USD", "Money{-4, USD}"},
+		{"base * (base / 5 USD)", "Money{20, USD}"},
+		{"base * max(1, 2, 1.5) / min(4, 2)", "Money{10, USD}"},
+		{"  12.5 usd ", "Money{12.5, USD}"},
+	} {
+		e, err := Parse(unit.source)
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		res, err := e.Eval(vars)
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		if res.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, res)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	vars := map[string]goprices.Money{
+		"base": newMoney(10, goprices.USD),
+		"cost": newMoney(5, goprices.EUR),
+	}
+	currencies := map[string]string{"base": goprices.USD, "cost": goprices.EUR}
+
+	type testUnit struct {
+		source string
+		pos    int
+		err    error
+	}
+	for index, unit := range []testUnit{
+		// syntax errors
+		{"base *", 6, ErrSyntax},
+		{"(base", 5, ErrSyntax},
+		{"base $ 1", 5, ErrSyntax},
+		{"base 1", 5, ErrSyntax},
+		{"1..2 USD", 0, ErrSyntax},
+		{"round(base, 1, 2)", 0, ErrSyntax},
+		{"floor(base)", 0, ErrUnknownFunction},
+		{"5 ABC", 2, goprices.ErrUnknownCurrency},
+		// check and evaluation errors
+		{"base + cost", 5, goprices.ErrNotSameCurrency},
+		{"max(base, cost)", 0, goprices.ErrNotSameCurrency},
+		{"base / cost", 5, goprices.ErrNotSameCurrency},
+		{"base + 1", 5, ErrType},
+		{"base * base", 5, ErrType},
+		{"2 / base", 2, ErrType},
+		{"min(base, 1)", 0, ErrType},
+		{"round(base, 1 USD)", 0, ErrType},
+		{"round(base, 4294967298)", 0, ErrType},
+		{"round(base, 29)", 0, ErrType},
+		{"round(base, 1.5)", 0, ErrType},
+		{"price * 2", 0, ErrUnknownVariable},
+		{"base / base", 0, ErrNotMoney},
+	} {
+		e, err := Parse(unit.source)
+		if err == nil {
+			if err = e.Check(currencies); err == nil {
+				t.Fatalf("Error at index: %d, expected check to fail", index)
+			}
+			assertError(t, index, err, unit.pos, unit.err)
+			_, err = e.Eval(vars)
+		}
+		assertError(t, index, err, unit.pos, unit.err)
+	}
+
+	// places computed at evaluation can not be checked beforehand
+	e := MustParse("round(base, 2 * 2147483649)")
+	if err := e.Check(currencies); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := e.Eval(vars); !errors.Is(err, ErrType) {
+		t.Fatalf("expected ErrType, got: %v", err)
+	}
+
+	for index, source := range []string{"base / 0", "base / (2 - 2)", "base * (base / (base - base))"} {
+		if _, err := MustParse(source).Eval(vars); !errors.Is(err, goprices.ErrDivisorZero) {
+			t.Fatalf("Error at index: %d, expected ErrDivisorZero, got: %v", index, err)
+		}
+	}
+
+	var mismatch *goprices.CurrencyMismatchError
+	if err := MustParse("base - cost").Check(currencies); !errors.As(err, &mismatch) || mismatch.Left != goprices.USD || mismatch.Right != goprices.EUR {
+		t.Fatalf("expected a *goprices.CurrencyMismatchError, got: %v", err)
+	}
+}
+
+func assertError(t *testing.T, index int, err error, pos int, target error) {
+	t.Helper()
+	var exprErr *Error
+	if !errors.As(err, &exprErr) {
+		t.Fatalf("Error at index: %d, expected an *Error, got: %v", index, err)
+	}
+	if exprErr.Pos != pos || !errors.Is(err, target) {
+		t.Fatalf("Error at index: %d, expected: %v at offset %d, got: %v", index, target, pos, err)
+	}
+}
+
+func TestVariables(t *testing.T) {
+	e := MustParse("max(cost * 1.3, base) + cost - 1 USD")
+	if names := e.Variables(); !reflect.DeepEqual(names, []string{"base", "cost"}) {
+		t.Fatalf("unexpected variables: %v", names)
+	}
+	if e.String() != "max(cost * 1.3, base) + cost - 1 USD" {
+		t.Fatalf("unexpected source: %s", e)
+	}
+}
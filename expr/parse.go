@@ -0,0 +1,299 @@
+package expr
+
+import (
+	"fmt"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+// node is a node of an expression tree
+type node interface {
+	pos() int
+}
+
+type number struct {
+	p     int
+	value decimal.Decimal
+}
+
+type money struct {
+	p     int
+	value goprices.Money
+}
+
+type variable struct {
+	p    int
+	name string
+}
+
+// unary is a negation
+type unary struct {
+	p int
+	x node
+}
+
+type binary struct {
+	p    int
+	op   byte
+	x, y node
+}
+
+type call struct {
+	p    int
+	name string
+	args []node
+}
+
+func (n *number) pos() int   { return n.p }
+func (n *money) pos() int    { return n.p }
+func (n *variable) pos() int { return n.p }
+func (n *unary) pos() int    { return n.p }
+func (n *binary) pos() int   { return n.p }
+func (n *call) pos() int     { return n.p }
+
+// functions contains minimum and maximum number of arguments of functions, -1 is unlimited
+var functions = map[string][2]int{
+	"min":   {1, -1},
+	"max":   {1, -1},
+	"round": {1, 2},
+}
+
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenPunct // one of + - * / ( ) , %
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+// scan splits given source into tokens, the last one is tokenEOF
+func scan(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := source[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case isDigit(c) || c == '.':
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{tokenNumber, source[start:i], start})
+		case isLetter(c):
+			for i < len(source) && (isLetter(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, source[start:i], start})
+		case c == '+' || c == '-' || c == '*' || c == '/' || c == '(' || c == ')' || c == ',' || c == '%':
+			i++
+			tokens = append(tokens, token{tokenPunct, source[start:i], start})
+		default:
+			return nil, &Error{Pos: i, Err: fmt.Errorf("%w: unexpected character %q", ErrSyntax, c)}
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(source)}), nil
+}
+
+// Parse parses given expression.
+//
+// Returned error could be `nil` or an *Error wrapping ErrSyntax, ErrUnknownFunction or a *goprices.UnknownCurrencyError
+func Parse(source string) (*Expr, error) {
+	tokens, err := scan(source)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	root, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.unexpected(tok)
+	}
+	return &Expr{source: source, root: root}, nil
+}
+
+// MustParse is like Parse but panics if error occurs, e.g: for expressions known at compile time
+func MustParse(source string) *Expr {
+	e, err := Parse(source)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// parser is a recursive descent parser of:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = "-" unary | primary
+//	primary = number [ "%" | currency ] | ident [ "(" expr { "," expr } ")" ] | "(" expr ")"
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokenEOF {
+		p.i++
+	}
+	return tok
+}
+
+// accept consumes next token if it is given punctuation
+func (p *parser) accept(punct string) bool {
+	if tok := p.peek(); tok.kind == tokenPunct && tok.text == punct {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(punct string) error {
+	if !p.accept(punct) {
+		return p.unexpected(p.peek())
+	}
+	return nil
+}
+
+func (p *parser) unexpected(tok token) error {
+	if tok.kind == tokenEOF {
+		return &Error{Pos: tok.pos, Err: fmt.Errorf("%w: unexpected end of expression", ErrSyntax)}
+	}
+	return &Error{Pos: tok.pos, Err: fmt.Errorf("%w: unexpected %q", ErrSyntax, tok.text)}
+}
+
+func (p *parser) expr() (node, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if !p.accept("+") && !p.accept("-") {
+			return x, nil
+		}
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = &binary{tok.pos, tok.text[0], x, y}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if !p.accept("*") && !p.accept("/") {
+			return x, nil
+		}
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binary{tok.pos, tok.text[0], x, y}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	tok := p.peek()
+	if p.accept("-") {
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{tok.pos, x}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		value, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, &Error{Pos: tok.pos, Err: fmt.Errorf("%w: invalid number %q", ErrSyntax, tok.text)}
+		}
+		if p.accept("%") {
+			return &number{tok.pos, value.Shift(-2)}, nil
+		}
+		// a number followed by an identifier is a money literal, e.g: 5 USD
+		if code := p.peek(); code.kind == tokenIdent {
+			p.next()
+			m, err := goprices.NewSignedMoneyFromDecimal(value, code.text)
+			if err != nil {
+				return nil, &Error{Pos: code.pos, Err: err}
+			}
+			return &money{tok.pos, *m}, nil
+		}
+		return &number{tok.pos, value}, nil
+
+	case tokenIdent:
+		if !p.accept("(") {
+			return &variable{tok.pos, tok.text}, nil
+		}
+		arity, ok := functions[tok.text]
+		if !ok {
+			return nil, &Error{Pos: tok.pos, Err: fmt.Errorf("%w: %s", ErrUnknownFunction, tok.text)}
+		}
+		c := &call{p: tok.pos, name: tok.text}
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, arg)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		if len(c.args) < arity[0] || (arity[1] >= 0 && len(c.args) > arity[1]) {
+			return nil, &Error{Pos: tok.pos, Err: fmt.Errorf("%w: wrong number of arguments to %s", ErrSyntax, tok.text)}
+		}
+		return c, nil
+
+	case tokenPunct:
+		if tok.text == "(" {
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, p.unexpected(tok)
+}
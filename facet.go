@@ -0,0 +1,201 @@
+package goprices
+
+import (
+	"sort"
+
+	"github.com/site-name/decimal"
+)
+
+// FacetMethod tells how Facets places bucket boundaries
+type FacetMethod uint8
+
+const (
+	// FacetEqualWidth splits the prices span into buckets of the same "nice" width, e.g: 0-25, 25-50, 50-75
+	FacetEqualWidth FacetMethod = iota
+	// FacetQuantile places boundaries so that buckets hold about the same number of prices.
+	// Boundaries are rounded down to "nice" values.
+	FacetQuantile
+	// FacetBreakpoints uses given breakpoints as boundaries
+	FacetBreakpoints
+)
+
+// FacetOptions configures Facets
+type FacetOptions struct {
+	Method FacetMethod
+	// Buckets is the maximum number of buckets, used by FacetEqualWidth and FacetQuantile
+	Buckets int
+	// Breakpoints are ascending bucket boundaries, used by FacetBreakpoints.
+	// Prices outside [first, last] breakpoint are not counted.
+	Breakpoints []decimal.Decimal
+	// FromGross tells to bucket TaxedMoney prices by gross instead of net
+	FromGross bool
+}
+
+// Facet is a price bucket with number of prices falling into it
+type Facet struct {
+	Range MoneyRange
+	Count int
+}
+
+// Facets builds price buckets over given prices, all of them must have given currency.
+// Buckets are half-open ranges [start, stop) sharing their boundaries, but the last one which is closed.
+// No bucket is returned for empty prices, unless the method is FacetBreakpoints.
+//
+// "Nice" boundaries are multiples of 1, 2, 2.5 or 5 times a power of ten, never finer than currency precision.
+//
+// Returned error could be `nil`, `ErrInvalidFacet`, an *UnknownCurrencyError or a *CurrencyMismatchError
+//
+// E.g:
+//
+//	Facets(USD, prices, FacetOptions{Method: FacetEqualWidth, Buckets: 4}) => [0-25: 3], [25-50: 8], [50-75: 1], [75-100: 2]
+func Facets[T Aggregatable](currency string, prices []T, options FacetOptions) ([]Facet, error) {
+	amounts := make([]decimal.Decimal, 0, len(prices))
+	unit, err := iterate(currency, seqOf(prices), func(value T) {
+		switch v := any(value).(type) {
+		case Money:
+			amounts = append(amounts, v.amount)
+		case TaxedMoney:
+			if options.FromGross {
+				amounts = append(amounts, v.gross.amount)
+			} else {
+				amounts = append(amounts, v.net.amount)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i].LessThan(amounts[j]) })
+
+	precision, err := GetCurrencyPrecision(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	var boundaries []decimal.Decimal
+	switch options.Method {
+	case FacetEqualWidth, FacetQuantile:
+		if options.Buckets < 1 {
+			return nil, ErrInvalidFacet
+		}
+		if len(amounts) == 0 {
+			return []Facet{}, nil
+		}
+		if options.Method == FacetEqualWidth {
+			boundaries = equalWidthBoundaries(amounts[0], amounts[len(amounts)-1], options.Buckets, int32(precision))
+		} else {
+			boundaries = quantileBoundaries(amounts, options.Buckets, int32(precision))
+		}
+
+	case FacetBreakpoints:
+		if len(options.Breakpoints) < 2 {
+			return nil, ErrInvalidFacet
+		}
+		for i := 1; i < len(options.Breakpoints); i++ {
+			if !options.Breakpoints[i-1].LessThan(options.Breakpoints[i]) {
+				return nil, ErrInvalidFacet
+			}
+		}
+		boundaries = options.Breakpoints
+
+	default:
+		return nil, ErrInvalidFacet
+	}
+
+	facets := make([]Facet, len(boundaries)-1)
+	last := len(facets) - 1
+	for i := range facets {
+		facets[i].Range = MoneyRange{
+			start:     Money{boundaries[i], unit},
+			stop:      Money{boundaries[i+1], unit},
+			stopBound: Exclusive,
+		}
+	}
+	facets[last].Range.stopBound = Inclusive
+	for _, amount := range amounts {
+		// index of the first boundary greater than amount
+		i := sort.Search(len(boundaries), func(i int) bool { return boundaries[i].GreaterThan(amount) })
+		switch {
+		case i == 0:
+			continue
+		case i == len(boundaries):
+			if amount.Equal(boundaries[len(boundaries)-1]) {
+				facets[last].Count++
+			}
+		default:
+			facets[i-1].Count++
+		}
+	}
+	return facets, nil
+}
+
+// niceStep returns the smallest of 1, 2, 2.5 or 5 times a power of ten which is
+// greater than or equal to raw, and is a multiple of 10^-precision.
+func niceStep(raw decimal.Decimal, precision int32) decimal.Decimal {
+	for exp := -precision; ; exp++ {
+		// multipliers are in tenths
+		for _, multiplier := range []int64{10, 20, 25, 50} {
+			if multiplier == 25 && exp == -precision {
+				continue
+			}
+			if step := decimal.New(multiplier, exp-1); step.GreaterThanOrEqual(raw) {
+				return step
+			}
+		}
+	}
+}
+
+// nextNiceStep returns the nice step following given one, e.g: 2 => 2.5, 5 => 10
+func nextNiceStep(step decimal.Decimal, precision int32) decimal.Decimal {
+	return niceStep(step.Add(decimal.New(1, -precision)), precision)
+}
+
+// floorTo rounds value down to a multiple of step
+func floorTo(value, step decimal.Decimal) decimal.Decimal {
+	return value.Div(step).Floor().Mul(step)
+}
+
+// equalWidthBoundaries returns at most n+1 boundaries, evenly spaced by a nice step, covering [min, max]
+func equalWidthBoundaries(min, max decimal.Decimal, n int, precision int32) []decimal.Decimal {
+	step := niceStep(max.Sub(min).Div(decimal.NewFromInt(int64(n))), precision)
+	for {
+		start := floorTo(min, step)
+		count := max.Sub(start).Div(step).Floor().IntPart() + 1
+		if floorTo(max, step).Equal(max) && count > 1 {
+			// max lies on a boundary, it is the stop of the last bucket
+			count--
+		}
+		if count <= int64(n) {
+			boundaries := make([]decimal.Decimal, count+1)
+			for i := range boundaries {
+				boundaries[i] = start.Add(step.Mul(decimal.NewFromInt(int64(i))))
+			}
+			return boundaries
+		}
+		step = nextNiceStep(step, precision)
+	}
+}
+
+// quantileBoundaries returns at most n+1 boundaries so that buckets hold about the same number of sorted amounts.
+// Inner boundaries are rounded down to a nice step, a tenth of the equal width step.
+func quantileBoundaries(amounts []decimal.Decimal, n int, precision int32) []decimal.Decimal {
+	min, max := amounts[0], amounts[len(amounts)-1]
+	granularity := niceStep(max.Sub(min).Div(decimal.NewFromInt(int64(n*10))), precision)
+
+	boundaries := []decimal.Decimal{floorTo(min, granularity)}
+	for i := 1; i < n; i++ {
+		boundary := floorTo(amounts[i*len(amounts)/n], granularity)
+		if boundary.GreaterThan(boundaries[len(boundaries)-1]) {
+			boundaries = append(boundaries, boundary)
+		}
+	}
+
+	stop := floorTo(max, granularity)
+	if stop.LessThan(max) || len(boundaries) == 1 {
+		stop = stop.Add(granularity)
+	}
+	if stop.GreaterThan(boundaries[len(boundaries)-1]) {
+		boundaries = append(boundaries, stop)
+	}
+	return boundaries
+}
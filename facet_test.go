@@ -0,0 +1,130 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestNiceStep(t *testing.T) {
+	type testUnit struct {
+		raw       float64
+		precision int32
+		expected  string
+	}
+	for index, unit := range []testUnit{
+		{0, 2, "0.01"},
+		{0.013, 2, "0.02"},
+		{0.021, 2, "0.05"},
+		{0.21, 2, "0.25"},
+		{23.75, 2, "25"},
+		{26, 2, "50"},
+		{3, 0, "5"},
+		{1, 0, "1"},
+		{140, 0, "200"},
+	} {
+		step := niceStep(decimal.NewFromFloat(unit.raw), unit.precision)
+		if step.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, step)
+		}
+	}
+}
+
+func facetsString(facets []Facet) []string {
+	res := make([]string, len(facets))
+	for i, facet := range facets {
+		res[i] = facet.Range.start.amount.String() + "-" + facet.Range.stop.amount.String() + ":" + decimal.NewFromInt(int64(facet.Count)).String()
+	}
+	return res
+}
+
+func assertFacets(t *testing.T, facets []Facet, expected ...string) {
+	t.Helper()
+	got := facetsString(facets)
+	if len(got) != len(expected) {
+		t.Fatalf("expected: %v, got: %v", expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Fatalf("expected: %v, got: %v", expected, got)
+		}
+	}
+}
+
+func moneyList(currency string, amounts ...float64) []Money {
+	res := make([]Money, len(amounts))
+	for i, amount := range amounts {
+		res[i] = *must(NewMoney(amount, currency))
+	}
+	return res
+}
+
+func TestFacetsEqualWidth(t *testing.T) {
+	prices := moneyList(USD, 3, 12.5, 24.99, 25, 49, 60, 98, 100)
+	facets, err := Facets(USD, prices, FacetOptions{Method: FacetEqualWidth, Buckets: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "0-25:3", "25-50:2", "50-75:1", "75-100:2")
+
+	facets, err = Facets(USD, moneyList(USD, 49, 51), FacetOptions{Method: FacetEqualWidth, Buckets: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "40-60:2")
+
+	facets, err = Facets(JPY, moneyList(JPY, 980, 980), FacetOptions{Method: FacetEqualWidth, Buckets: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "980-981:2")
+
+	facets, err = Facets[Money](USD, nil, FacetOptions{Method: FacetEqualWidth, Buckets: 3})
+	if err != nil || len(facets) != 0 {
+		t.Fatalf("expected no facets, got: %v, %v", facets, err)
+	}
+}
+
+func TestFacetsQuantile(t *testing.T) {
+	prices := moneyList(USD, 1, 2, 3, 4, 5, 6, 7, 100)
+	facets, err := Facets(USD, prices, FacetOptions{Method: FacetQuantile, Buckets: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "0-5:4", "5-100:4")
+}
+
+func TestFacetsBreakpoints(t *testing.T) {
+	prices := []TaxedMoney{
+		*must(NewTaxedMoneyFromFloats(8, 10, EUR)),
+		*must(NewTaxedMoneyFromFloats(20, 25, EUR)),
+		*must(NewTaxedMoneyFromFloats(40, 50, EUR)),
+		*must(NewTaxedMoneyFromFloats(80, 100, EUR)),
+	}
+	options := FacetOptions{
+		Method:      FacetBreakpoints,
+		Breakpoints: []decimal.Decimal{decimal.NewFromInt(10), decimal.NewFromInt(25), decimal.NewFromInt(50)},
+		FromGross:   true,
+	}
+	facets, err := Facets(EUR, prices, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "10-25:1", "25-50:2")
+
+	options.FromGross = false
+	facets, err = Facets(EUR, prices, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertFacets(t, facets, "10-25:1", "25-50:1")
+
+	options.Breakpoints = []decimal.Decimal{decimal.NewFromInt(10), decimal.NewFromInt(10)}
+	if _, err := Facets(EUR, prices, options); !errors.Is(err, ErrInvalidFacet) {
+		t.Fatalf("expected ErrInvalidFacet, got: %v", err)
+	}
+	if _, err := Facets(USD, prices, FacetOptions{Method: FacetEqualWidth, Buckets: 2}); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+//go:build ignore
+
+// This program generates iso4217.go from data/iso4217.csv. Invoke it as:
+//
+//	go generate
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	input  = "data/iso4217.csv"
+	output = "iso4217.go"
+
+	// naFraction is used for codes ISO defines no minor unit for (metals, XDR, ...),
+	// it matches the CLDR default used by golang.org/x/text.
+	naFraction = 2
+)
+
+var (
+	codeRe      = regexp.MustCompile(`^[A-Z]{3}$`)
+	numericRe   = regexp.MustCompile(`^[0-9]{3}$`)
+	withdrawnRe = regexp.MustCompile(`^([0-9]{4}-(0[1-9]|1[0-2]))?$`)
+)
+
+type entry struct {
+	code, numeric, name, withdrawn string
+	fraction                       int
+	fund                           bool
+}
+
+func main() {
+	file, err := os.Open(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 6
+
+	var (
+		entries  []entry
+		seen     = map[string]bool{}
+		numerics = map[string]string{}
+	)
+	for line := 0; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if line == 0 {
+			continue // header
+		}
+
+		e := entry{code: record[0], numeric: record[1], name: record[3], withdrawn: record[5]}
+		if !codeRe.MatchString(e.code) || seen[e.code] {
+			log.Fatalf("%s: invalid or duplicated code %q", input, e.code)
+		}
+		if !numericRe.MatchString(e.numeric) {
+			log.Fatalf("%s: invalid numeric code %q for %s", input, e.numeric, e.code)
+		}
+		if other, ok := numerics[e.numeric]; ok {
+			log.Fatalf("%s: numeric code %s used by both %s and %s", input, e.numeric, other, e.code)
+		}
+		if !withdrawnRe.MatchString(e.withdrawn) {
+			log.Fatalf("%s: invalid withdrawal date %q for %s", input, e.withdrawn, e.code)
+		}
+		if record[2] == "N.A." {
+			e.fraction = naFraction
+		} else if e.fraction, err = strconv.Atoi(record[2]); err != nil {
+			log.Fatalf("%s: invalid minor units %q for %s", input, record[2], e.code)
+		}
+		if e.fund, err = strconv.ParseBool(record[4]); err != nil {
+			log.Fatalf("%s: invalid fund flag %q for %s", input, record[4], e.code)
+		}
+
+		seen[e.code] = true
+		numerics[e.numeric] = e.code
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].code < entries[j].code })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go run gen_iso4217.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package goprices\n\n")
+	fmt.Fprintf(&buf, "// isoCurrencies contains ISO 4217 data generated from %s.\n", input)
+	fmt.Fprintf(&buf, "var isoCurrencies = map[string]isoCurrency{\n")
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "\t%q: {NumericCode: %q, Fraction: %d, Name: %q, Fund: %t, Withdrawn: %q},\n",
+			e.code, e.numeric, e.fraction, e.name, e.fund, e.withdrawn)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
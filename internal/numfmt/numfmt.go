@@ -0,0 +1,43 @@
+// Package numfmt localizes decimal amounts with currency separators,
+// it is shared by goprices CSV price lists and internal/pricing formatting.
+package numfmt
+
+import "strings"
+
+// Localize replaces separators of given amount, e.g: "-1234.5" => "-1.234,5" for "," decimal and "." thousand separators
+func Localize(amount, decimalSep, thousandSep string) string {
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(amount, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(thousandSep)
+		}
+		b.WriteRune(digit)
+	}
+	if hasFraction {
+		b.WriteString(decimalSep)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// Delocalize reverts Localize, e.g: "1.234,5" => "1234.5" for "," decimal and "." thousand separators.
+// Non-breaking spaces are accepted for a space thousand separator.
+func Delocalize(amount, decimalSep, thousandSep string) string {
+	if thousandSep != "" {
+		amount = strings.ReplaceAll(amount, thousandSep, "")
+	}
+	if thousandSep == " " {
+		amount = strings.NewReplacer("\u00a0", "", "\u202f", "").Replace(amount)
+	}
+	if decimalSep != "." {
+		amount = strings.Replace(amount, decimalSep, ".", 1)
+	}
+	return amount
+}
@@ -0,0 +1,30 @@
+package numfmt
+
+import "testing"
+
+func TestLocalize(t *testing.T) {
+	type testUnit struct {
+		amount      string
+		decimalSep  string
+		thousandSep string
+		expected    string
+	}
+	for index, unit := range []testUnit{
+		{"-1234.5", ",", ".", "-1.234,5"},
+		{"1234567", ".", ",", "1,234,567"},
+		{"123.45", ".", ",", "123.45"},
+		{"1234.5", ",", "", "1234,5"},
+	} {
+		localized := Localize(unit.amount, unit.decimalSep, unit.thousandSep)
+		if localized != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, localized)
+		}
+		if amount := Delocalize(localized, unit.decimalSep, unit.thousandSep); amount != unit.amount {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.amount, amount)
+		}
+	}
+
+	if amount := Delocalize("1\u00a0234,5", ",", " "); amount != "1234.5" {
+		t.Fatalf("expected non-breaking space to be removed, got: %s", amount)
+	}
+}
@@ -0,0 +1,85 @@
+package pricing
+
+import (
+	"math/big"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+// Allocate splits given money into parts proportional to given ratios, without losing or creating
+// a single minor unit: parts always sum up to m. Remaining minor units are given one by one
+// to the first parts having a non zero ratio.
+//
+// Returned error could be `nil`, `ErrInvalidAllocation` if ratios are empty, negative or all zero,
+// `goprices.ErrPrecisionLoss` if m has more decimal places than its currency allows, or a *goprices.UnknownCurrencyError
+//
+// E.g:
+//
+//	Allocate(10 USD, 1, 1, 1) => [3.34 USD, 3.33 USD, 3.33 USD]
+//	Allocate(0.05 USD, 3, 7) => [0.02 USD, 0.03 USD]
+func Allocate(m goprices.Money, ratios ...int) ([]goprices.Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrInvalidAllocation
+	}
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidAllocation
+		}
+		total += int64(ratio)
+	}
+	if total == 0 {
+		return nil, ErrInvalidAllocation
+	}
+
+	precision, err := goprices.GetCurrencyPrecision(m.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	units := m.GetAmount().Shift(int32(precision))
+	if !units.IsInteger() {
+		return nil, goprices.ErrPrecisionLoss
+	}
+	amount := units.BigInt()
+
+	shares := make([]*big.Int, len(ratios))
+	remainder := new(big.Int).Set(amount)
+	for i, ratio := range ratios {
+		shares[i] = new(big.Int).Mul(amount, big.NewInt(int64(ratio)))
+		shares[i].Quo(shares[i], big.NewInt(total))
+		remainder.Sub(remainder, shares[i])
+	}
+
+	// the remainder is less than the number of parts, its sign is the sign of m
+	step := big.NewInt(int64(remainder.Sign()))
+	for i := 0; remainder.Sign() != 0; i++ {
+		if ratios[i] != 0 {
+			shares[i].Add(shares[i], step)
+			remainder.Sub(remainder, step)
+		}
+	}
+
+	res := make([]goprices.Money, len(shares))
+	for i, share := range shares {
+		res[i] = m
+		res[i].SetAmount(decimal.NewFromBigInt(share, -int32(precision)))
+	}
+	return res, nil
+}
+
+// Split splits given money into given number of parts as equal as possible. See Allocate
+//
+// E.g:
+//
+//	Split(10 USD, 3) => [3.34 USD, 3.33 USD, 3.33 USD]
+func Split(m goprices.Money, parts int) ([]goprices.Money, error) {
+	if parts < 1 {
+		return nil, ErrInvalidAllocation
+	}
+	ratios := make([]int, parts)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return Allocate(m, ratios...)
+}
@@ -0,0 +1,61 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	goprices "github.com/site-name/go-prices"
+)
+
+func TestAllocate(t *testing.T) {
+	type testUnit struct {
+		money    goprices.Money
+		ratios   []int
+		expected string
+		err      error
+	}
+	for index, unit := range []testUnit{
+		{newMoney("10", goprices.USD), []int{1, 1, 1}, "[Money{3.34, USD} Money{3.33, USD} Money{3.33, USD}]", nil},
+		{newMoney("0.05", goprices.USD), []int{3, 7}, "[Money{0.02, USD} Money{0.03, USD}]", nil},
+		{newMoney("0.05", goprices.USD), []int{0, 1, 1}, "[Money{0, USD} Money{0.03, USD} Money{0.02, USD}]", nil},
+		{newMoney("-10", goprices.USD), []int{1, 1, 1}, "[Money{-3.34, USD} Money{-3.33, USD} Money{-3.33, USD}]", nil},
+		{newMoney("100", goprices.JPY), []int{1, 2}, "[Money{34, JPY} Money{66, JPY}]", nil},
+		{newMoney("10", goprices.USD), nil, "", ErrInvalidAllocation},
+		{newMoney("10", goprices.USD), []int{0, 0}, "", ErrInvalidAllocation},
+		{newMoney("10", goprices.USD), []int{-1, 2}, "", ErrInvalidAllocation},
+		{newMoney("0.001", goprices.USD), []int{1, 1}, "", goprices.ErrPrecisionLoss},
+	} {
+		parts, err := Allocate(unit.money, unit.ratios...)
+		if unit.err != nil {
+			if !errors.Is(err, unit.err) {
+				t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		if got := fmt.Sprint(parts); got != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, got)
+		}
+		sum, err := goprices.Sum(unit.money.GetCurrency(), parts)
+		if err != nil || !sum.Equal(unit.money) {
+			t.Fatalf("Error at index: %d, expected sum: %s, got: %s, %v", index, unit.money, sum, err)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	parts, err := Split(newMoney("1", goprices.EUR), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "[Money{0.17, EUR} Money{0.17, EUR} Money{0.17, EUR} Money{0.17, EUR} Money{0.16, EUR} Money{0.16, EUR}]"
+	if got := fmt.Sprint(parts); got != expected {
+		t.Fatalf("expected: %s, got: %s", expected, got)
+	}
+	if _, err := Split(newMoney("1", goprices.EUR), 0); !errors.Is(err, ErrInvalidAllocation) {
+		t.Fatalf("expected ErrInvalidAllocation, got: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package pricing
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+// ExchangeRates contains exchange rates of currencies against a base currency:
+// 1 Base is worth Rates[code] code. Rates between other currencies are computed through the base.
+//
+// Its JSON representation is, e.g:
+//
+//	{"base":"USD","rates":{"EUR":"0.92","JPY":151.3}}
+type ExchangeRates struct {
+	Base  string                     `json:"base"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+// NewExchangeRates returns exchange rates of given currencies against base, currency codes are upper cased.
+//
+// Returned error could be `nil`, `ErrInvalidExchangeRate` if a rate is not positive or a *goprices.UnknownCurrencyError
+func NewExchangeRates(base string, rates map[string]decimal.Decimal) (ExchangeRates, error) {
+	b, err := goprices.GetCurrency(base)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+	normalized := make(map[string]decimal.Decimal, len(rates))
+	for code, rate := range rates {
+		c, err := goprices.GetCurrency(code)
+		if err != nil {
+			return ExchangeRates{}, err
+		}
+		if !rate.IsPositive() {
+			return ExchangeRates{}, ErrInvalidExchangeRate
+		}
+		normalized[c.Code] = rate
+	}
+	return ExchangeRates{Base: b.Code, Rates: normalized}, nil
+}
+
+// ReadExchangeRates reads exchange rates in JSON from r. See NewExchangeRates
+//
+// Returned error could be `nil`, a JSON error, `ErrInvalidExchangeRate` or a *goprices.UnknownCurrencyError
+func ReadExchangeRates(r io.Reader) (ExchangeRates, error) {
+	var v ExchangeRates
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return ExchangeRates{}, err
+	}
+	return NewExchangeRates(v.Base, v.Rates)
+}
+
+// rate returns the rate of given currency against the base
+func (r ExchangeRates) rate(currency string) (decimal.Decimal, error) {
+	c, err := goprices.GetCurrency(currency)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if base, err := goprices.GetCurrency(r.Base); err == nil && c.Code == base.Code {
+		return decimal.NewFromInt(1), nil
+	}
+	rate, ok := r.Rates[c.Code]
+	if !ok || !rate.IsPositive() {
+		return decimal.Zero, ErrInvalidExchangeRate
+	}
+	return rate, nil
+}
+
+// Convert converts given money to given currency. Result is rounded half up to currency precision.
+//
+// Returned error could be `nil`, `ErrInvalidExchangeRate` if a rate is missing or not positive, or a *goprices.UnknownCurrencyError
+//
+// E.g:
+//
+//	rates := ExchangeRates{Base: goprices.USD, Rates: map[string]decimal.Decimal{goprices.EUR: decimal.RequireFromString("0.92")}}
+//	Convert(10 EUR, goprices.USD, rates) => 10.87 USD
+func Convert(m goprices.Money, to string, rates ExchangeRates) (*goprices.Money, error) {
+	from, err := rates.rate(m.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	rate, err := rates.rate(to)
+	if err != nil {
+		return nil, err
+	}
+	c, _ := goprices.GetCurrency(to)
+	return goprices.NewSignedMoneyFromDecimal(m.GetAmount().Mul(rate).DivRound(from, int32(c.Fraction)), c.Code)
+}
@@ -0,0 +1,81 @@
+package pricing
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+func TestConvert(t *testing.T) {
+	rates, err := ReadExchangeRates(strings.NewReader(`{"base":"usd","rates":{"eur":"0.92","JPY":151.3}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testUnit struct {
+		money    goprices.Money
+		to       string
+		expected string
+		err      error
+	}
+	for index, unit := range []testUnit{
+		{newMoney("10", goprices.USD), goprices.EUR, "Money{9.2, EUR}", nil},
+		{newMoney("10", goprices.EUR), goprices.USD, "Money{10.87, USD}", nil},
+		{newMoney("10", goprices.EUR), goprices.JPY, "Money{1645, JPY}", nil},
+		{newMoney("10", goprices.USD), "usd", "Money{10, USD}", nil},
+		{newMoney("10", goprices.USD), goprices.GBP, "", ErrInvalidExchangeRate},
+		{newMoney("10", goprices.GBP), goprices.USD, "", ErrInvalidExchangeRate},
+		{newMoney("10", goprices.USD), "ABC", "", goprices.ErrUnknownCurrency},
+	} {
+		converted, err := Convert(unit.money, unit.to, rates)
+		if unit.err != nil {
+			if !errors.Is(err, unit.err) {
+				t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		if converted.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, converted)
+		}
+	}
+}
+
+func TestReadExchangeRates(t *testing.T) {
+	type testUnit struct {
+		data string
+		err  error
+	}
+	for index, unit := range []testUnit{
+		{`{"base":"ABC","rates":{}}`, goprices.ErrUnknownCurrency},
+		{`{"base":"USD","rates":{"ABC":1}}`, goprices.ErrUnknownCurrency},
+		{`{"base":"USD","rates":{"EUR":0}}`, ErrInvalidExchangeRate},
+		{`{"base":"USD","rates":{"EUR":"-1"}}`, ErrInvalidExchangeRate},
+	} {
+		if _, err := ReadExchangeRates(strings.NewReader(unit.data)); !errors.Is(err, unit.err) {
+			t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+		}
+	}
+	if _, err := ReadExchangeRates(strings.NewReader(`{`)); err == nil {
+		t.Fatal("expected a JSON error")
+	}
+}
+
+func TestNewExchangeRates(t *testing.T) {
+	rates, err := NewExchangeRates("usd", map[string]decimal.Decimal{"eur": decimal.RequireFromString("0.92")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates.Base != goprices.USD || len(rates.Rates) != 1 || !rates.Rates[goprices.EUR].Equal(decimal.RequireFromString("0.92")) {
+		t.Fatalf("expected normalized rates, got: %v", rates)
+	}
+
+	if _, err := NewExchangeRates("", map[string]decimal.Decimal{goprices.EUR: decimal.NewFromInt(1)}); !errors.Is(err, goprices.ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
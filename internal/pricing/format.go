@@ -0,0 +1,77 @@
+package pricing
+
+import (
+	"strings"
+
+	goprices "github.com/site-name/go-prices"
+	"github.com/site-name/go-prices/internal/numfmt"
+)
+
+// Format formats given money the way its currency is written, with its symbol (Grapheme),
+// Template and separators. Amount is formatted as by goprices.Money.MarshalText.
+//
+// Returned error could be `nil` or a *goprices.UnknownCurrencyError
+//
+// E.g:
+//
+//	Format(1234.5 USD) => "$1,234.50"
+//	Format(-3 EUR) => "-€3.00"
+func Format(m goprices.Money) (string, error) {
+	c, err := goprices.GetCurrency(m.GetCurrency())
+	if err != nil {
+		return "", err
+	}
+	text, err := m.Abs().MarshalText()
+	if err != nil {
+		return "", err
+	}
+	_, amount, _ := strings.Cut(string(text), " ")
+
+	sign, number := "", numfmt.Localize(amount, c.Decimal, c.Thousand)
+	if m.IsNegative() {
+		sign = "-"
+	}
+	before, after, _ := strings.Cut(c.Template, "1")
+	symbol := strings.NewReplacer("$", c.Grapheme)
+	return sign + symbol.Replace(before) + number + symbol.Replace(after), nil
+}
+
+// Parse parses an amount of given currency written by Format, or with the currency code instead of its symbol.
+// Negative amounts are accepted.
+//
+// Returned error could be `nil`, `goprices.ErrInvalidEncoding` or a *goprices.UnknownCurrencyError
+//
+// E.g:
+//
+//	Parse("$1,234.50", USD) => 1234.50 USD
+//	Parse("1.234,50 BRL", BRL) => 1234.50 BRL
+func Parse(text, currency string) (*goprices.Money, error) {
+	c, err := goprices.GetCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	text = strings.TrimSpace(text)
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+	if c.Grapheme != "" {
+		text = strings.Replace(text, c.Grapheme, "", 1)
+	}
+	text = strings.TrimSpace(strings.Replace(text, c.Code, "", 1))
+	if !negative {
+		negative = strings.HasPrefix(text, "-")
+		text = strings.TrimPrefix(text, "-")
+	}
+
+	var m goprices.Money
+	if err := m.UnmarshalText([]byte(c.Code + " " + numfmt.Delocalize(text, c.Decimal, c.Thousand))); err != nil {
+		return nil, err
+	}
+	if m.IsNegative() {
+		return nil, goprices.ErrInvalidEncoding
+	}
+	if negative {
+		m = m.Neg()
+	}
+	return &m, nil
+}
@@ -0,0 +1,78 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	goprices "github.com/site-name/go-prices"
+)
+
+func TestFormat(t *testing.T) {
+	type testUnit struct {
+		money    goprices.Money
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{newMoney("1234.5", goprices.USD), "$1,234.50"},
+		{newMoney("-3", goprices.EUR), "-€3.00"},
+		{newMoney("1234567.891", goprices.BRL), "R$1.234.567,891"},
+		{newMoney("1000", goprices.BYN), "1 000,00 p."},
+		{newMoney("12", goprices.JPY), "¥12"},
+		{newMoney("0.5", goprices.USD), "$0.50"},
+	} {
+		text, err := Format(unit.money)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, text)
+		}
+
+		parsed, err := Parse(text, unit.money.GetCurrency())
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		if !parsed.Equal(unit.money) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.money, parsed)
+		}
+	}
+
+	var unknown goprices.Money
+	unknown.SetCurrency("ABC")
+	if _, err := Format(unknown); !errors.Is(err, goprices.ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	type testUnit struct {
+		text     string
+		currency string
+		expected string
+		err      error
+	}
+	for index, unit := range []testUnit{
+		{"1234.50 USD", goprices.USD, "Money{1234.5, USD}", nil},
+		{" $-5 ", "usd", "Money{-5, USD}", nil},
+		{"1.234,50 BRL", goprices.BRL, "Money{1234.5, BRL}", nil},
+		{"12", goprices.EUR, "Money{12, EUR}", nil},
+		{"", goprices.USD, "", goprices.ErrInvalidEncoding},
+		{"$1.2.3", goprices.USD, "", goprices.ErrInvalidEncoding},
+		{"--5", goprices.USD, "", goprices.ErrInvalidEncoding},
+		{"5", "ABC", "", goprices.ErrUnknownCurrency},
+	} {
+		money, err := Parse(unit.text, unit.currency)
+		if unit.err != nil {
+			if !errors.Is(err, unit.err) {
+				t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+		if money.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, money)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Package pricing contains calculations shared by the goprices command and the server package:
+// allocation, flat taxes, currency conversion and formatting with currency symbols.
+//
+// They are built on the public API of goprices and are not part of it.
+package pricing
+
+import "errors"
+
+var (
+	ErrInvalidTaxRate      = errors.New("tax rate must not be negative")
+	ErrInvalidAllocation   = errors.New("allocation ratios must not be negative and must not all be zero")
+	ErrInvalidExchangeRate = errors.New("exchange rate is missing or not positive")
+)
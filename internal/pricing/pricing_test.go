@@ -0,0 +1,14 @@
+package pricing
+
+import (
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+func newMoney(amount string, currency string) goprices.Money {
+	m, err := goprices.NewSignedMoneyFromDecimal(decimal.RequireFromString(amount), currency)
+	if err != nil {
+		panic(err)
+	}
+	return *m
+}
@@ -0,0 +1,34 @@
+package pricing
+
+import (
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+// FlatTax returns taxed money computed from given base and tax rate, e.g: 0.2 for 20%.
+// When fromGross is true, base is the gross price (tax included) and net is computed from it,
+// otherwise base is the net price. The computed amount is rounded half up to currency precision.
+//
+// Returned error could be `nil`, `ErrInvalidTaxRate` or a *goprices.UnknownCurrencyError
+//
+// E.g:
+//
+//	FlatTax(10 USD, 0.2, false) => TaxedMoney{net: 10 USD, gross: 12 USD}
+//	FlatTax(10 USD, 0.2, true) => TaxedMoney{net: 8.33 USD, gross: 10 USD}
+func FlatTax(base goprices.Money, rate decimal.Decimal, fromGross bool) (*goprices.TaxedMoney, error) {
+	if rate.IsNegative() {
+		return nil, ErrInvalidTaxRate
+	}
+	precision, err := goprices.GetCurrencyPrecision(base.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	factor := decimal.NewFromInt(1).Add(rate)
+	computed := base
+	if fromGross {
+		computed.SetAmount(base.GetAmount().DivRound(factor, int32(precision)))
+		return goprices.NewSignedTaxedMoney(computed, base)
+	}
+	computed.SetAmount(base.GetAmount().Mul(factor).Round(int32(precision)))
+	return goprices.NewSignedTaxedMoney(base, computed)
+}
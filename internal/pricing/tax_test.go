@@ -0,0 +1,37 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+)
+
+func TestFlatTax(t *testing.T) {
+	type testUnit struct {
+		base      goprices.Money
+		rate      string
+		fromGross bool
+		expected  string
+	}
+	for index, unit := range []testUnit{
+		{newMoney("10", goprices.USD), "0.2", false, "TaxedMoney{net=Money{10, USD}, gross=Money{12, USD}}"},
+		{newMoney("10", goprices.USD), "0.2", true, "TaxedMoney{net=Money{8.33, USD}, gross=Money{10, USD}}"},
+		{newMoney("9.99", goprices.EUR), "0.075", false, "TaxedMoney{net=Money{9.99, EUR}, gross=Money{10.74, EUR}}"},
+		{newMoney("100", goprices.JPY), "0.1", true, "TaxedMoney{net=Money{91, JPY}, gross=Money{100, JPY}}"},
+		{newMoney("10", goprices.USD), "0", true, "TaxedMoney{net=Money{10, USD}, gross=Money{10, USD}}"},
+	} {
+		taxed, err := FlatTax(unit.base, decimal.RequireFromString(unit.rate), unit.fromGross)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if taxed.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, taxed)
+		}
+	}
+
+	if _, err := FlatTax(newMoney("10", goprices.USD), decimal.NewFromFloat(-0.1), false); !errors.Is(err, ErrInvalidTaxRate) {
+		t.Fatalf("expected ErrInvalidTaxRate, got: %v", err)
+	}
+}
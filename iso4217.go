@@ -0,0 +1,201 @@
+// Code generated by go run gen_iso4217.go; DO NOT EDIT.
+
+package goprices
+
+// isoCurrencies contains ISO 4217 data generated from data/iso4217.csv.
+var isoCurrencies = map[string]isoCurrency{
+	"AED": {NumericCode: "784", Fraction: 2, Name: "UAE Dirham", Fund: false, Withdrawn: ""},
+	"AFN": {NumericCode: "971", Fraction: 2, Name: "Afghani", Fund: false, Withdrawn: ""},
+	"ALL": {NumericCode: "008", Fraction: 2, Name: "Lek", Fund: false, Withdrawn: ""},
+	"AMD": {NumericCode: "051", Fraction: 2, Name: "Armenian Dram", Fund: false, Withdrawn: ""},
+	"ANG": {NumericCode: "532", Fraction: 2, Name: "Netherlands Antillean Guilder", Fund: false, Withdrawn: ""},
+	"AOA": {NumericCode: "973", Fraction: 2, Name: "Kwanza", Fund: false, Withdrawn: ""},
+	"ARS": {NumericCode: "032", Fraction: 2, Name: "Argentine Peso", Fund: false, Withdrawn: ""},
+	"AUD": {NumericCode: "036", Fraction: 2, Name: "Australian Dollar", Fund: false, Withdrawn: ""},
+	"AWG": {NumericCode: "533", Fraction: 2, Name: "Aruban Florin", Fund: false, Withdrawn: ""},
+	"AZN": {NumericCode: "944", Fraction: 2, Name: "Azerbaijan Manat", Fund: false, Withdrawn: ""},
+	"BAM": {NumericCode: "977", Fraction: 2, Name: "Convertible Mark", Fund: false, Withdrawn: ""},
+	"BBD": {NumericCode: "052", Fraction: 2, Name: "Barbados Dollar", Fund: false, Withdrawn: ""},
+	"BDT": {NumericCode: "050", Fraction: 2, Name: "Taka", Fund: false, Withdrawn: ""},
+	"BGN": {NumericCode: "975", Fraction: 2, Name: "Bulgarian Lev", Fund: false, Withdrawn: ""},
+	"BHD": {NumericCode: "048", Fraction: 3, Name: "Bahraini Dinar", Fund: false, Withdrawn: ""},
+	"BIF": {NumericCode: "108", Fraction: 0, Name: "Burundi Franc", Fund: false, Withdrawn: ""},
+	"BMD": {NumericCode: "060", Fraction: 2, Name: "Bermudian Dollar", Fund: false, Withdrawn: ""},
+	"BND": {NumericCode: "096", Fraction: 2, Name: "Brunei Dollar", Fund: false, Withdrawn: ""},
+	"BOB": {NumericCode: "068", Fraction: 2, Name: "Boliviano", Fund: false, Withdrawn: ""},
+	"BOV": {NumericCode: "984", Fraction: 2, Name: "Mvdol", Fund: true, Withdrawn: ""},
+	"BRL": {NumericCode: "986", Fraction: 2, Name: "Brazilian Real", Fund: false, Withdrawn: ""},
+	"BSD": {NumericCode: "044", Fraction: 2, Name: "Bahamian Dollar", Fund: false, Withdrawn: ""},
+	"BTN": {NumericCode: "064", Fraction: 2, Name: "Ngultrum", Fund: false, Withdrawn: ""},
+	"BWP": {NumericCode: "072", Fraction: 2, Name: "Pula", Fund: false, Withdrawn: ""},
+	"BYN": {NumericCode: "933", Fraction: 2, Name: "Belarusian Ruble", Fund: false, Withdrawn: ""},
+	"BYR": {NumericCode: "974", Fraction: 0, Name: "Belarusian Ruble", Fund: false, Withdrawn: "2017-01"},
+	"BZD": {NumericCode: "084", Fraction: 2, Name: "Belize Dollar", Fund: false, Withdrawn: ""},
+	"CAD": {NumericCode: "124", Fraction: 2, Name: "Canadian Dollar", Fund: false, Withdrawn: ""},
+	"CDF": {NumericCode: "976", Fraction: 2, Name: "Congolese Franc", Fund: false, Withdrawn: ""},
+	"CHE": {NumericCode: "947", Fraction: 2, Name: "WIR Euro", Fund: true, Withdrawn: ""},
+	"CHF": {NumericCode: "756", Fraction: 2, Name: "Swiss Franc", Fund: false, Withdrawn: ""},
+	"CHW": {NumericCode: "948", Fraction: 2, Name: "WIR Franc", Fund: true, Withdrawn: ""},
+	"CLF": {NumericCode: "990", Fraction: 4, Name: "Unidad de Fomento", Fund: true, Withdrawn: ""},
+	"CLP": {NumericCode: "152", Fraction: 0, Name: "Chilean Peso", Fund: false, Withdrawn: ""},
+	"CNY": {NumericCode: "156", Fraction: 2, Name: "Yuan Renminbi", Fund: false, Withdrawn: ""},
+	"COP": {NumericCode: "170", Fraction: 2, Name: "Colombian Peso", Fund: false, Withdrawn: ""},
+	"COU": {NumericCode: "970", Fraction: 2, Name: "Unidad de Valor Real", Fund: true, Withdrawn: ""},
+	"CRC": {NumericCode: "188", Fraction: 2, Name: "Costa Rican Colon", Fund: false, Withdrawn: ""},
+	"CUC": {NumericCode: "931", Fraction: 2, Name: "Peso Convertible", Fund: false, Withdrawn: ""},
+	"CUP": {NumericCode: "192", Fraction: 2, Name: "Cuban Peso", Fund: false, Withdrawn: ""},
+	"CVE": {NumericCode: "132", Fraction: 2, Name: "Cabo Verde Escudo", Fund: false, Withdrawn: ""},
+	"CZK": {NumericCode: "203", Fraction: 2, Name: "Czech Koruna", Fund: false, Withdrawn: ""},
+	"DJF": {NumericCode: "262", Fraction: 0, Name: "Djibouti Franc", Fund: false, Withdrawn: ""},
+	"DKK": {NumericCode: "208", Fraction: 2, Name: "Danish Krone", Fund: false, Withdrawn: ""},
+	"DOP": {NumericCode: "214", Fraction: 2, Name: "Dominican Peso", Fund: false, Withdrawn: ""},
+	"DZD": {NumericCode: "012", Fraction: 2, Name: "Algerian Dinar", Fund: false, Withdrawn: ""},
+	"EEK": {NumericCode: "233", Fraction: 2, Name: "Kroon", Fund: false, Withdrawn: "2011-01"},
+	"EGP": {NumericCode: "818", Fraction: 2, Name: "Egyptian Pound", Fund: false, Withdrawn: ""},
+	"ERN": {NumericCode: "232", Fraction: 2, Name: "Nakfa", Fund: false, Withdrawn: ""},
+	"ETB": {NumericCode: "230", Fraction: 2, Name: "Ethiopian Birr", Fund: false, Withdrawn: ""},
+	"EUR": {NumericCode: "978", Fraction: 2, Name: "Euro", Fund: false, Withdrawn: ""},
+	"FJD": {NumericCode: "242", Fraction: 2, Name: "Fiji Dollar", Fund: false, Withdrawn: ""},
+	"FKP": {NumericCode: "238", Fraction: 2, Name: "Falkland Islands Pound", Fund: false, Withdrawn: ""},
+	"GBP": {NumericCode: "826", Fraction: 2, Name: "Pound Sterling", Fund: false, Withdrawn: ""},
+	"GEL": {NumericCode: "981", Fraction: 2, Name: "Lari", Fund: false, Withdrawn: ""},
+	"GHC": {NumericCode: "288", Fraction: 2, Name: "Cedi", Fund: false, Withdrawn: "2008-01"},
+	"GHS": {NumericCode: "936", Fraction: 2, Name: "Ghana Cedi", Fund: false, Withdrawn: ""},
+	"GIP": {NumericCode: "292", Fraction: 2, Name: "Gibraltar Pound", Fund: false, Withdrawn: ""},
+	"GMD": {NumericCode: "270", Fraction: 2, Name: "Dalasi", Fund: false, Withdrawn: ""},
+	"GNF": {NumericCode: "324", Fraction: 0, Name: "Guinean Franc", Fund: false, Withdrawn: ""},
+	"GTQ": {NumericCode: "320", Fraction: 2, Name: "Quetzal", Fund: false, Withdrawn: ""},
+	"GYD": {NumericCode: "328", Fraction: 2, Name: "Guyana Dollar", Fund: false, Withdrawn: ""},
+	"HKD": {NumericCode: "344", Fraction: 2, Name: "Hong Kong Dollar", Fund: false, Withdrawn: ""},
+	"HNL": {NumericCode: "340", Fraction: 2, Name: "Lempira", Fund: false, Withdrawn: ""},
+	"HRK": {NumericCode: "191", Fraction: 2, Name: "Kuna", Fund: false, Withdrawn: "2023-01"},
+	"HTG": {NumericCode: "332", Fraction: 2, Name: "Gourde", Fund: false, Withdrawn: ""},
+	"HUF": {NumericCode: "348", Fraction: 2, Name: "Forint", Fund: false, Withdrawn: ""},
+	"IDR": {NumericCode: "360", Fraction: 2, Name: "Rupiah", Fund: false, Withdrawn: ""},
+	"ILS": {NumericCode: "376", Fraction: 2, Name: "New Israeli Sheqel", Fund: false, Withdrawn: ""},
+	"INR": {NumericCode: "356", Fraction: 2, Name: "Indian Rupee", Fund: false, Withdrawn: ""},
+	"IQD": {NumericCode: "368", Fraction: 3, Name: "Iraqi Dinar", Fund: false, Withdrawn: ""},
+	"IRR": {NumericCode: "364", Fraction: 2, Name: "Iranian Rial", Fund: false, Withdrawn: ""},
+	"ISK": {NumericCode: "352", Fraction: 0, Name: "Iceland Krona", Fund: false, Withdrawn: ""},
+	"JMD": {NumericCode: "388", Fraction: 2, Name: "Jamaican Dollar", Fund: false, Withdrawn: ""},
+	"JOD": {NumericCode: "400", Fraction: 3, Name: "Jordanian Dinar", Fund: false, Withdrawn: ""},
+	"JPY": {NumericCode: "392", Fraction: 0, Name: "Yen", Fund: false, Withdrawn: ""},
+	"KES": {NumericCode: "404", Fraction: 2, Name: "Kenyan Shilling", Fund: false, Withdrawn: ""},
+	"KGS": {NumericCode: "417", Fraction: 2, Name: "Som", Fund: false, Withdrawn: ""},
+	"KHR": {NumericCode: "116", Fraction: 2, Name: "Riel", Fund: false, Withdrawn: ""},
+	"KMF": {NumericCode: "174", Fraction: 0, Name: "Comorian Franc", Fund: false, Withdrawn: ""},
+	"KPW": {NumericCode: "408", Fraction: 2, Name: "North Korean Won", Fund: false, Withdrawn: ""},
+	"KRW": {NumericCode: "410", Fraction: 0, Name: "Won", Fund: false, Withdrawn: ""},
+	"KWD": {NumericCode: "414", Fraction: 3, Name: "Kuwaiti Dinar", Fund: false, Withdrawn: ""},
+	"KYD": {NumericCode: "136", Fraction: 2, Name: "Cayman Islands Dollar", Fund: false, Withdrawn: ""},
+	"KZT": {NumericCode: "398", Fraction: 2, Name: "Tenge", Fund: false, Withdrawn: ""},
+	"LAK": {NumericCode: "418", Fraction: 2, Name: "Lao Kip", Fund: false, Withdrawn: ""},
+	"LBP": {NumericCode: "422", Fraction: 2, Name: "Lebanese Pound", Fund: false, Withdrawn: ""},
+	"LKR": {NumericCode: "144", Fraction: 2, Name: "Sri Lanka Rupee", Fund: false, Withdrawn: ""},
+	"LRD": {NumericCode: "430", Fraction: 2, Name: "Liberian Dollar", Fund: false, Withdrawn: ""},
+	"LSL": {NumericCode: "426", Fraction: 2, Name: "Loti", Fund: false, Withdrawn: ""},
+	"LTL": {NumericCode: "440", Fraction: 2, Name: "Lithuanian Litas", Fund: false, Withdrawn: "2014-12"},
+	"LVL": {NumericCode: "428", Fraction: 2, Name: "Latvian Lats", Fund: false, Withdrawn: "2014-01"},
+	"LYD": {NumericCode: "434", Fraction: 3, Name: "Libyan Dinar", Fund: false, Withdrawn: ""},
+	"MAD": {NumericCode: "504", Fraction: 2, Name: "Moroccan Dirham", Fund: false, Withdrawn: ""},
+	"MDL": {NumericCode: "498", Fraction: 2, Name: "Moldovan Leu", Fund: false, Withdrawn: ""},
+	"MGA": {NumericCode: "969", Fraction: 2, Name: "Malagasy Ariary", Fund: false, Withdrawn: ""},
+	"MKD": {NumericCode: "807", Fraction: 2, Name: "Denar", Fund: false, Withdrawn: ""},
+	"MMK": {NumericCode: "104", Fraction: 2, Name: "Kyat", Fund: false, Withdrawn: ""},
+	"MNT": {NumericCode: "496", Fraction: 2, Name: "Tugrik", Fund: false, Withdrawn: ""},
+	"MOP": {NumericCode: "446", Fraction: 2, Name: "Pataca", Fund: false, Withdrawn: ""},
+	"MRO": {NumericCode: "478", Fraction: 2, Name: "Ouguiya", Fund: false, Withdrawn: "2017-12"},
+	"MRU": {NumericCode: "929", Fraction: 2, Name: "Ouguiya", Fund: false, Withdrawn: ""},
+	"MUR": {NumericCode: "480", Fraction: 2, Name: "Mauritius Rupee", Fund: false, Withdrawn: ""},
+	"MVR": {NumericCode: "462", Fraction: 2, Name: "Rufiyaa", Fund: false, Withdrawn: ""},
+	"MWK": {NumericCode: "454", Fraction: 2, Name: "Malawi Kwacha", Fund: false, Withdrawn: ""},
+	"MXN": {NumericCode: "484", Fraction: 2, Name: "Mexican Peso", Fund: false, Withdrawn: ""},
+	"MXV": {NumericCode: "979", Fraction: 2, Name: "Mexican Unidad de Inversion (UDI)", Fund: true, Withdrawn: ""},
+	"MYR": {NumericCode: "458", Fraction: 2, Name: "Malaysian Ringgit", Fund: false, Withdrawn: ""},
+	"MZN": {NumericCode: "943", Fraction: 2, Name: "Mozambique Metical", Fund: false, Withdrawn: ""},
+	"NAD": {NumericCode: "516", Fraction: 2, Name: "Namibia Dollar", Fund: false, Withdrawn: ""},
+	"NGN": {NumericCode: "566", Fraction: 2, Name: "Naira", Fund: false, Withdrawn: ""},
+	"NIO": {NumericCode: "558", Fraction: 2, Name: "Cordoba Oro", Fund: false, Withdrawn: ""},
+	"NOK": {NumericCode: "578", Fraction: 2, Name: "Norwegian Krone", Fund: false, Withdrawn: ""},
+	"NPR": {NumericCode: "524", Fraction: 2, Name: "Nepalese Rupee", Fund: false, Withdrawn: ""},
+	"NZD": {NumericCode: "554", Fraction: 2, Name: "New Zealand Dollar", Fund: false, Withdrawn: ""},
+	"OMR": {NumericCode: "512", Fraction: 3, Name: "Rial Omani", Fund: false, Withdrawn: ""},
+	"PAB": {NumericCode: "590", Fraction: 2, Name: "Balboa", Fund: false, Withdrawn: ""},
+	"PEN": {NumericCode: "604", Fraction: 2, Name: "Sol", Fund: false, Withdrawn: ""},
+	"PGK": {NumericCode: "598", Fraction: 2, Name: "Kina", Fund: false, Withdrawn: ""},
+	"PHP": {NumericCode: "608", Fraction: 2, Name: "Philippine Peso", Fund: false, Withdrawn: ""},
+	"PKR": {NumericCode: "586", Fraction: 2, Name: "Pakistan Rupee", Fund: false, Withdrawn: ""},
+	"PLN": {NumericCode: "985", Fraction: 2, Name: "Zloty", Fund: false, Withdrawn: ""},
+	"PYG": {NumericCode: "600", Fraction: 0, Name: "Guarani", Fund: false, Withdrawn: ""},
+	"QAR": {NumericCode: "634", Fraction: 2, Name: "Qatari Rial", Fund: false, Withdrawn: ""},
+	"RON": {NumericCode: "946", Fraction: 2, Name: "Romanian Leu", Fund: false, Withdrawn: ""},
+	"RSD": {NumericCode: "941", Fraction: 2, Name: "Serbian Dinar", Fund: false, Withdrawn: ""},
+	"RUB": {NumericCode: "643", Fraction: 2, Name: "Russian Ruble", Fund: false, Withdrawn: ""},
+	"RUR": {NumericCode: "810", Fraction: 2, Name: "Russian Ruble", Fund: false, Withdrawn: "1998-01"},
+	"RWF": {NumericCode: "646", Fraction: 0, Name: "Rwanda Franc", Fund: false, Withdrawn: ""},
+	"SAR": {NumericCode: "682", Fraction: 2, Name: "Saudi Riyal", Fund: false, Withdrawn: ""},
+	"SBD": {NumericCode: "090", Fraction: 2, Name: "Solomon Islands Dollar", Fund: false, Withdrawn: ""},
+	"SCR": {NumericCode: "690", Fraction: 2, Name: "Seychelles Rupee", Fund: false, Withdrawn: ""},
+	"SDG": {NumericCode: "938", Fraction: 2, Name: "Sudanese Pound", Fund: false, Withdrawn: ""},
+	"SEK": {NumericCode: "752", Fraction: 2, Name: "Swedish Krona", Fund: false, Withdrawn: ""},
+	"SGD": {NumericCode: "702", Fraction: 2, Name: "Singapore Dollar", Fund: false, Withdrawn: ""},
+	"SHP": {NumericCode: "654", Fraction: 2, Name: "Saint Helena Pound", Fund: false, Withdrawn: ""},
+	"SKK": {NumericCode: "703", Fraction: 2, Name: "Slovak Koruna", Fund: false, Withdrawn: "2009-01"},
+	"SLE": {NumericCode: "925", Fraction: 2, Name: "Leone", Fund: false, Withdrawn: ""},
+	"SLL": {NumericCode: "694", Fraction: 2, Name: "Leone", Fund: false, Withdrawn: ""},
+	"SOS": {NumericCode: "706", Fraction: 2, Name: "Somali Shilling", Fund: false, Withdrawn: ""},
+	"SRD": {NumericCode: "968", Fraction: 2, Name: "Surinam Dollar", Fund: false, Withdrawn: ""},
+	"SSP": {NumericCode: "728", Fraction: 2, Name: "South Sudanese Pound", Fund: false, Withdrawn: ""},
+	"STD": {NumericCode: "678", Fraction: 2, Name: "Dobra", Fund: false, Withdrawn: "2017-12"},
+	"STN": {NumericCode: "930", Fraction: 2, Name: "Dobra", Fund: false, Withdrawn: ""},
+	"SVC": {NumericCode: "222", Fraction: 2, Name: "El Salvador Colon", Fund: false, Withdrawn: ""},
+	"SYP": {NumericCode: "760", Fraction: 2, Name: "Syrian Pound", Fund: false, Withdrawn: ""},
+	"SZL": {NumericCode: "748", Fraction: 2, Name: "Lilangeni", Fund: false, Withdrawn: ""},
+	"THB": {NumericCode: "764", Fraction: 2, Name: "Baht", Fund: false, Withdrawn: ""},
+	"TJS": {NumericCode: "972", Fraction: 2, Name: "Somoni", Fund: false, Withdrawn: ""},
+	"TMT": {NumericCode: "934", Fraction: 2, Name: "Turkmenistan New Manat", Fund: false, Withdrawn: ""},
+	"TND": {NumericCode: "788", Fraction: 3, Name: "Tunisian Dinar", Fund: false, Withdrawn: ""},
+	"TOP": {NumericCode: "776", Fraction: 2, Name: "Pa'anga", Fund: false, Withdrawn: ""},
+	"TRL": {NumericCode: "792", Fraction: 0, Name: "Turkish Lira", Fund: false, Withdrawn: "2005-12"},
+	"TRY": {NumericCode: "949", Fraction: 2, Name: "Turkish Lira", Fund: false, Withdrawn: ""},
+	"TTD": {NumericCode: "780", Fraction: 2, Name: "Trinidad and Tobago Dollar", Fund: false, Withdrawn: ""},
+	"TWD": {NumericCode: "901", Fraction: 2, Name: "New Taiwan Dollar", Fund: false, Withdrawn: ""},
+	"TZS": {NumericCode: "834", Fraction: 2, Name: "Tanzanian Shilling", Fund: false, Withdrawn: ""},
+	"UAH": {NumericCode: "980", Fraction: 2, Name: "Hryvnia", Fund: false, Withdrawn: ""},
+	"UGX": {NumericCode: "800", Fraction: 0, Name: "Uganda Shilling", Fund: false, Withdrawn: ""},
+	"USD": {NumericCode: "840", Fraction: 2, Name: "US Dollar", Fund: false, Withdrawn: ""},
+	"USN": {NumericCode: "997", Fraction: 2, Name: "US Dollar (Next day)", Fund: true, Withdrawn: ""},
+	"UYI": {NumericCode: "940", Fraction: 0, Name: "Uruguay Peso en Unidades Indexadas (UI)", Fund: true, Withdrawn: ""},
+	"UYU": {NumericCode: "858", Fraction: 2, Name: "Peso Uruguayo", Fund: false, Withdrawn: ""},
+	"UYW": {NumericCode: "927", Fraction: 4, Name: "Unidad Previsional", Fund: false, Withdrawn: ""},
+	"UZS": {NumericCode: "860", Fraction: 2, Name: "Uzbekistan Sum", Fund: false, Withdrawn: ""},
+	"VED": {NumericCode: "926", Fraction: 2, Name: "Bolívar Soberano", Fund: false, Withdrawn: ""},
+	"VEF": {NumericCode: "937", Fraction: 2, Name: "Bolívar", Fund: false, Withdrawn: "2018-08"},
+	"VES": {NumericCode: "928", Fraction: 2, Name: "Bolívar Soberano", Fund: false, Withdrawn: ""},
+	"VND": {NumericCode: "704", Fraction: 0, Name: "Dong", Fund: false, Withdrawn: ""},
+	"VUV": {NumericCode: "548", Fraction: 0, Name: "Vatu", Fund: false, Withdrawn: ""},
+	"WST": {NumericCode: "882", Fraction: 2, Name: "Tala", Fund: false, Withdrawn: ""},
+	"XAF": {NumericCode: "950", Fraction: 0, Name: "CFA Franc BEAC", Fund: false, Withdrawn: ""},
+	"XAG": {NumericCode: "961", Fraction: 2, Name: "Silver", Fund: false, Withdrawn: ""},
+	"XAU": {NumericCode: "959", Fraction: 2, Name: "Gold", Fund: false, Withdrawn: ""},
+	"XBA": {NumericCode: "955", Fraction: 2, Name: "Bond Markets Unit European Composite Unit (EURCO)", Fund: false, Withdrawn: ""},
+	"XBB": {NumericCode: "956", Fraction: 2, Name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)", Fund: false, Withdrawn: ""},
+	"XBC": {NumericCode: "957", Fraction: 2, Name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", Fund: false, Withdrawn: ""},
+	"XBD": {NumericCode: "958", Fraction: 2, Name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", Fund: false, Withdrawn: ""},
+	"XCD": {NumericCode: "951", Fraction: 2, Name: "East Caribbean Dollar", Fund: false, Withdrawn: ""},
+	"XDR": {NumericCode: "960", Fraction: 2, Name: "SDR (Special Drawing Right)", Fund: false, Withdrawn: ""},
+	"XOF": {NumericCode: "952", Fraction: 0, Name: "CFA Franc BCEAO", Fund: false, Withdrawn: ""},
+	"XPD": {NumericCode: "964", Fraction: 2, Name: "Palladium", Fund: false, Withdrawn: ""},
+	"XPF": {NumericCode: "953", Fraction: 0, Name: "CFP Franc", Fund: false, Withdrawn: ""},
+	"XPT": {NumericCode: "962", Fraction: 2, Name: "Platinum", Fund: false, Withdrawn: ""},
+	"XSU": {NumericCode: "994", Fraction: 2, Name: "Sucre", Fund: false, Withdrawn: ""},
+	"XTS": {NumericCode: "963", Fraction: 2, Name: "Codes specifically reserved for testing purposes", Fund: false, Withdrawn: ""},
+	"XUA": {NumericCode: "965", Fraction: 2, Name: "ADB Unit of Account", Fund: false, Withdrawn: ""},
+	"XXX": {NumericCode: "999", Fraction: 2, Name: "The codes assigned for transactions where no currency is involved", Fund: false, Withdrawn: ""},
+	"YER": {NumericCode: "886", Fraction: 2, Name: "Yemeni Rial", Fund: false, Withdrawn: ""},
+	"ZAR": {NumericCode: "710", Fraction: 2, Name: "Rand", Fund: false, Withdrawn: ""},
+	"ZMW": {NumericCode: "967", Fraction: 2, Name: "Zambian Kwacha", Fund: false, Withdrawn: ""},
+	"ZWD": {NumericCode: "716", Fraction: 2, Name: "Zimbabwe Dollar", Fund: false, Withdrawn: "2008-08"},
+	"ZWG": {NumericCode: "924", Fraction: 2, Name: "Zimbabwe Gold", Fund: false, Withdrawn: ""},
+	"ZWL": {NumericCode: "932", Fraction: 2, Name: "Zimbabwe Dollar", Fund: false, Withdrawn: "2024-09"},
+}
@@ -0,0 +1,77 @@
+package goprices
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/currency"
+)
+
+// newerThanCLDR lists ISO codes introduced after the CLDR data shipped with golang.org/x/text.
+var newerThanCLDR = map[string]bool{
+	MRU: true,
+	SLE: true,
+	UYW: true,
+	VED: true,
+	VES: true,
+	ZWG: true,
+}
+
+// isoDataDate is the date data/iso4217.csv is up to date with, its latest withdrawal is ZWL in 2024-09.
+// Tests check x/text at this date rather than now, so they do not depend on when they run.
+var isoDataDate = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func TestISO4217ConsistentWithXText(t *testing.T) {
+	for code, iso := range isoCurrencies {
+		if iso.Withdrawn == "" && !IsISOCurrency(code) && !newerThanCLDR[code] {
+			t.Errorf("active currency %s is unknown to x/text", code)
+		}
+		if newerThanCLDR[code] && IsISOCurrency(code) {
+			t.Errorf("x/text now knows %s, remove it from newerThanCLDR", code)
+		}
+	}
+
+	// x/text may lag withdrawals, but every currency it considers legal tender must be known.
+	iter := currency.Query(currency.Date(isoDataDate))
+	for iter.Next() {
+		code := iter.Unit().String()
+		if _, ok := isoCurrencies[code]; !ok {
+			t.Errorf("currency %s used in %s is missing", code, iter.Region())
+		}
+	}
+}
+
+func TestISO4217Registered(t *testing.T) {
+	for code, iso := range isoCurrencies {
+		c, ok := DefaultRegistry.Lookup(code)
+		if !ok {
+			t.Fatalf("currency %s is not registered", code)
+		}
+		if c.Fraction != iso.Fraction || c.NumericCode != iso.NumericCode || c.Withdrawn != iso.Withdrawn {
+			t.Fatalf("currency %s is registered with %+v, expected %+v", code, c, iso)
+		}
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	type testUnit struct {
+		code     string
+		expected bool
+	}
+	testCases := []testUnit{
+		{USD, true},
+		{"ves", true},
+		{MRU, true},
+		{ZWG, true},
+		{BYR, false},
+		{VEF, false},
+		{ZWD, false},
+		{BTC, true},
+		{"ABC", false},
+	}
+	for index, test := range testCases {
+		if active := IsActive(test.code); active != test.expected {
+			t.Fatalf("Error at index: %d, expected: %t, got: %t", index, test.expected, active)
+		}
+	}
+}
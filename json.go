@@ -0,0 +1,195 @@
+package goprices
+
+import (
+	"encoding/json"
+
+	"github.com/site-name/decimal"
+)
+
+// moneyJSON is JSON representation of Money, amount is encoded as a string to avoid precision loss
+type moneyJSON struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON implements json.Marshaler interface, e.g:
+//
+//	{"amount":"12.5","currency":"USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{m.amount, m.currency})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface. Amount can be either a JSON string or number.
+// Negative amounts are accepted, since arithmetic results can be negative.
+//
+// Returned error could be `nil`, a JSON syntax error or an *UnknownCurrencyError
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	res, err := NewSignedMoneyFromDecimal(v.Amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = *res
+	return nil
+}
+
+type taxedMoneyJSON struct {
+	Net   Money `json:"net"`
+	Gross Money `json:"gross"`
+}
+
+// MarshalJSON implements json.Marshaler interface, e.g:
+//
+//	{"net":{"amount":"10","currency":"USD"},"gross":{"amount":"12.3","currency":"USD"}}
+func (t TaxedMoney) MarshalJSON() ([]byte, error) {
+	return json.Marshal(taxedMoneyJSON{t.net, t.gross})
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+//
+// Returned error could be `nil`, a JSON syntax error, an *UnknownCurrencyError or a *CurrencyMismatchError
+func (t *TaxedMoney) UnmarshalJSON(data []byte) error {
+	var v taxedMoneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	res, err := newTaxedMoney(v.Net, v.Gross)
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
+// rangeJSON is JSON representation of ranges of T, unbounded ends are null.
+// Bounds use interval notation, e.g: "[)". Empty bounds mean "[]".
+type rangeJSON[T any] struct {
+	Start    *T     `json:"start"`
+	Stop     *T     `json:"stop"`
+	Bounds   string `json:"bounds"`
+	Currency string `json:"currency"`
+}
+
+func newRangeJSON[T any](start, stop rangeEnd[T], currency string) rangeJSON[T] {
+	res := rangeJSON[T]{
+		Bounds:   start.bound.startBracket() + stop.bound.stopBracket(),
+		Currency: currency,
+	}
+	if start.bound != Unbounded {
+		res.Start = &start.value
+	}
+	if stop.bound != Unbounded {
+		res.Stop = &stop.value
+	}
+	return res
+}
+
+// bounds parses bounds of current range JSON
+func (r rangeJSON[T]) bounds() (startBound, stopBound Bound, err error) {
+	bounds := r.Bounds
+	if bounds == "" {
+		bounds = "[]"
+	}
+	if len(bounds) != 2 {
+		return 0, 0, ErrInvalidBound
+	}
+
+	switch {
+	case r.Start == nil:
+		startBound = Unbounded
+	case bounds[0] == '[':
+		startBound = Inclusive
+	case bounds[0] == '(':
+		startBound = Exclusive
+	default:
+		return 0, 0, ErrInvalidBound
+	}
+
+	switch {
+	case r.Stop == nil:
+		stopBound = Unbounded
+	case bounds[1] == ']':
+		stopBound = Inclusive
+	case bounds[1] == ')':
+		stopBound = Exclusive
+	default:
+		return 0, 0, ErrInvalidBound
+	}
+	return startBound, stopBound, nil
+}
+
+// MarshalJSON implements json.Marshaler interface, e.g:
+//
+//	{"start":{"amount":"20","currency":"USD"},"stop":null,"bounds":"[)","currency":"USD"}
+func (m MoneyRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newRangeJSON(m.startEnd(), m.stopEnd(), m.GetCurrency()))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface. Negative ends are accepted.
+//
+// Returned error could be `nil`, a JSON syntax error, `ErrInvalidBound`, an *UnknownCurrencyError,
+// a *CurrencyMismatchError or an *InvalidRangeError
+func (m *MoneyRange) UnmarshalJSON(data []byte) error {
+	var v rangeJSON[Money]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	startBound, stopBound, err := v.bounds()
+	if err != nil {
+		return err
+	}
+
+	start, stop := Money{currency: v.Currency}, Money{currency: v.Currency}
+	if v.Start != nil {
+		start = *v.Start
+	}
+	if v.Stop != nil {
+		stop = *v.Stop
+	}
+	res, err := newMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return err
+	}
+	if err := checkDeclaredCurrency(v.Currency, res.GetCurrency()); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface. See MoneyRange.MarshalJSON
+func (t TaxedMoneyRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newRangeJSON(t.startEnd(), t.stopEnd(), t.GetCurrency()))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface. See MoneyRange.UnmarshalJSON
+func (t *TaxedMoneyRange) UnmarshalJSON(data []byte) error {
+	var v rangeJSON[TaxedMoney]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	startBound, stopBound, err := v.bounds()
+	if err != nil {
+		return err
+	}
+
+	start, stop := zeroOf[TaxedMoney](v.Currency), zeroOf[TaxedMoney](v.Currency)
+	if v.Start != nil {
+		start = *v.Start
+	}
+	if v.Stop != nil {
+		stop = *v.Stop
+	}
+	res, err := newTaxedMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return err
+	}
+	if err := checkDeclaredCurrency(v.Currency, res.GetCurrency()); err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
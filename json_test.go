@@ -0,0 +1,115 @@
+package goprices
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoneyJSON(t *testing.T) {
+	money := *must(NewMoney(12.5, USD))
+	data, err := json.Marshal(money)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"amount":"12.5","currency":"USD"}` {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	var decoded Money
+	if err := json.Unmarshal([]byte(`{"amount":-0.1,"currency":"usd"}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(*must(NewSignedMoney(-0.1, USD))) {
+		t.Fatalf("unexpected money: %s", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount":"1","currency":"abc"}`), &decoded); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestTaxedMoneyJSON(t *testing.T) {
+	taxed := *must(NewTaxedMoneyFromFloats(10, 12.3, EUR))
+	data, err := json.Marshal(taxed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded TaxedMoney
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(taxed) {
+		t.Fatalf("expected: %s, got: %s", taxed, decoded)
+	}
+
+	mismatch := `{"net":{"amount":"1","currency":"USD"},"gross":{"amount":"1","currency":"EUR"}}`
+	if err := json.Unmarshal([]byte(mismatch), &decoded); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
+
+func TestMoneyRangeJSON(t *testing.T) {
+	from := *must(NewMoneyRangeFrom(*must(NewMoney(20, USD)), Inclusive))
+	data, err := json.Marshal(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"start":{"amount":"20","currency":"USD"},"stop":null,"bounds":"[)","currency":"USD"}` {
+		t.Fatalf("unexpected JSON: %s", data)
+	}
+
+	var decoded MoneyRange
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(from) {
+		t.Fatalf("expected: %s, got: %s", from, decoded)
+	}
+
+	type testUnit struct {
+		data     string
+		expected string
+		err      error
+	}
+	for index, unit := range []testUnit{
+		{`{"start":{"amount":"1","currency":"USD"},"stop":{"amount":"2","currency":"USD"}}`, "MoneyRange[Money{1, USD}, Money{2, USD}]", nil},
+		{`{"start":{"amount":"1","currency":"USD"},"stop":{"amount":"2","currency":"USD"},"bounds":"(]"}`, "MoneyRange(Money{1, USD}, Money{2, USD}]", nil},
+		{`{"start":null,"stop":null,"currency":"EUR"}`, "MoneyRange(-inf, +inf)", nil},
+		{`{"start":{"amount":"1","currency":"USD"},"stop":null,"bounds":"{)"}`, "", ErrInvalidBound},
+		{`{"start":{"amount":"2","currency":"USD"},"stop":{"amount":"1","currency":"USD"}}`, "", ErrStopLessThanStart},
+		{`{"start":{"amount":"1","currency":"USD"},"stop":null,"currency":"EUR"}`, "", ErrNotSameCurrency},
+	} {
+		var decoded MoneyRange
+		err := json.Unmarshal([]byte(unit.data), &decoded)
+		if unit.err != nil {
+			if !errors.Is(err, unit.err) {
+				t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+			}
+			continue
+		}
+		if err != nil || decoded.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s, %v", index, unit.expected, decoded, err)
+		}
+	}
+}
+
+func TestTaxedMoneyRangeJSON(t *testing.T) {
+	taxedRange := *must(NewTaxedMoneyRangeWithBounds(
+		*must(NewTaxedMoneyFromFloats(10, 12, USD)), Exclusive,
+		*must(NewTaxedMoneyFromFloats(20, 24, USD)), Inclusive,
+	))
+	data, err := json.Marshal(taxedRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded TaxedMoneyRange
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(taxedRange) {
+		t.Fatalf("expected: %s, got: %s", taxedRange, decoded)
+	}
+}
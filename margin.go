@@ -0,0 +1,111 @@
+package goprices
+
+import "github.com/site-name/decimal"
+
+// PriceFromMargin returns the selling price earning given margin on cost,
+// computed as: cost / (1 - margin), then quantized with given rounding.
+//
+// margin is a ratio of the selling price and must be less than 1, e.g: 0.25 means 25%.
+// For TaxedMoney, both net and gross are scaled so the tax rate is kept.
+func PriceFromMargin[K MoneyObject, T MoneyInterface[K]](cost T, margin decimal.Decimal, rounding Rounding) (*K, error) {
+	one := decimal.NewFromInt(1)
+	if margin.GreaterThanOrEqual(one) {
+		return nil, ErrInvalidMargin
+	}
+	return ptr(cost.scale(one, one.Sub(margin), rounding))
+}
+
+// PriceFromMarkup returns the selling price with given markup on cost,
+// computed as: cost * (1 + markup), then quantized with given rounding.
+//
+// markup is a ratio of the cost and must not be less than -1, e.g: 0.5 means 50%.
+func PriceFromMarkup[K MoneyObject, T MoneyInterface[K]](cost T, markup decimal.Decimal, rounding Rounding) (*K, error) {
+	one := decimal.NewFromInt(1)
+	if markup.LessThan(one.Neg()) {
+		return nil, ErrInvalidMarkup
+	}
+	return ptr(cost.scale(one.Add(markup), one, rounding))
+}
+
+// Margin returns the margin ratio of price over cost, computed as: (price - cost) / price.
+//
+// Returned error could be a *CurrencyMismatchError or ErrDivisorZero when price is zero.
+func Margin(cost, price Money) (decimal.Decimal, error) {
+	if !cost.SameKind(price) {
+		return decimal.Zero, &CurrencyMismatchError{Left: cost.currency, Right: price.currency}
+	}
+	if price.amount.IsZero() {
+		return decimal.Zero, ErrDivisorZero
+	}
+	return price.amount.Sub(cost.amount).Div(price.amount), nil
+}
+
+// TaxedMoneyMargin returns the margin ratio of price over cost, computed on net amounts.
+func TaxedMoneyMargin(cost, price TaxedMoney) (decimal.Decimal, error) {
+	return Margin(cost.net, price.net)
+}
+
+// MoneyRangeMargin returns margin ratios of price over cost for both ends of the ranges.
+// Both ranges must be bounded, otherwise ErrRangeUnbounded is returned.
+func MoneyRangeMargin(cost, price MoneyRange) (start, stop decimal.Decimal, err error) {
+	if !cost.IsBounded() || !price.IsBounded() {
+		return decimal.Zero, decimal.Zero, ErrRangeUnbounded
+	}
+	start, err = Margin(cost.start, price.start)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	stop, err = Margin(cost.stop, price.stop)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	return start, stop, nil
+}
+
+// scale returns m * numerator / denominator, quantized with given rounding.
+func (m Money) scale(numerator, denominator decimal.Decimal, rounding Rounding) (Money, error) {
+	if denominator.IsZero() {
+		return Money{}, ErrDivisorZero
+	}
+	res := Money{
+		amount:   m.amount.Mul(numerator).Div(denominator),
+		currency: m.currency,
+	}
+	return res.quantize(rounding, -1)
+}
+
+func (t TaxedMoney) scale(numerator, denominator decimal.Decimal, rounding Rounding) (TaxedMoney, error) {
+	net, err := t.net.scale(numerator, denominator, rounding)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	gross, err := t.gross.scale(numerator, denominator, rounding)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	return newTaxedMoney(net, gross)
+}
+
+func (m MoneyRange) scale(numerator, denominator decimal.Decimal, rounding Rounding) (MoneyRange, error) {
+	start, err := m.start.scale(numerator, denominator, rounding)
+	if err != nil {
+		return MoneyRange{}, err
+	}
+	stop, err := m.stop.scale(numerator, denominator, rounding)
+	if err != nil {
+		return MoneyRange{}, err
+	}
+	return newMoneyRange(start, stop, m.startBound, m.stopBound)
+}
+
+func (t TaxedMoneyRange) scale(numerator, denominator decimal.Decimal, rounding Rounding) (TaxedMoneyRange, error) {
+	start, err := t.start.scale(numerator, denominator, rounding)
+	if err != nil {
+		return TaxedMoneyRange{}, err
+	}
+	stop, err := t.stop.scale(numerator, denominator, rounding)
+	if err != nil {
+		return TaxedMoneyRange{}, err
+	}
+	return newTaxedMoneyRange(start, stop, t.startBound, t.stopBound)
+}
@@ -0,0 +1,74 @@
+package goprices
+
+import (
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestPriceFromMargin(t *testing.T) {
+	cost, err := NewMoney(3, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price, err := PriceFromMargin(cost, decimal.NewFromFloat(0.25), Floor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !price.amount.Equal(decimal.NewFromInt(4)) {
+		t.Fatalf("expected 4, got: %s", price)
+	}
+
+	price, err = PriceFromMargin(cost, decimal.NewFromFloat(0.3), Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.amount.String() != "4.29" {
+		t.Fatalf("expected 4.29, got: %s", price)
+	}
+
+	_, err = PriceFromMargin(cost, decimal.NewFromInt(1), Up)
+	if err != ErrInvalidMargin {
+		t.Fatalf("expected ErrInvalidMargin, got: %v", err)
+	}
+}
+
+func TestPriceFromMarkup(t *testing.T) {
+	cost, err := NewTaxedMoneyFromFloats(10, 12, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	price, err := PriceFromMarkup(cost, decimal.NewFromFloat(0.5), Up)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !price.net.amount.Equal(decimal.NewFromInt(15)) || !price.gross.amount.Equal(decimal.NewFromInt(18)) {
+		t.Fatalf("unexpected price: %s", price)
+	}
+}
+
+func TestMargin(t *testing.T) {
+	cost, err := NewTaxedMoneyFromFloats(30, 36, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	price, err := NewTaxedMoneyFromFloats(40, 48, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	margin, err := TaxedMoneyMargin(*cost, *price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !margin.Equal(decimal.NewFromFloat(0.25)) {
+		t.Fatalf("expected 0.25, got: %s", margin)
+	}
+
+	_, err = Margin(cost.net, Money{amount: decimal.Zero, currency: USD})
+	if err != ErrDivisorZero {
+		t.Fatalf("expected ErrDivisorZero, got: %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package goprices
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/site-name/decimal"
+)
+
+// MinorMoney represents an amount of a particular currency counted in its minor units (e.g cents).
+//
+// It is a lightweight alternative to Money for hot paths: it is backed by an int64,
+// every operation returns a value and never allocates.
+//
+// NOTE: high precision currencies like ETH (18 decimals) overflow an int64 above ~9.2 units, use Money for them.
+type MinorMoney struct {
+	amount   int64
+	currency string
+}
+
+// NewMinorMoney returns new MinorMoney holding given amount of minor units.
+//
+// E.g:
+//
+//	NewMinorMoney(1250, "usd") => MinorMoney{1250, USD}, which is 12.50 USD
+func NewMinorMoney(amount int64, currency string) (MinorMoney, error) {
+	unit, err := validateCurrency(currency)
+	if err != nil {
+		return MinorMoney{}, err
+	}
+	if _, err := GetCurrencyPrecision(unit); err != nil {
+		return MinorMoney{}, err
+	}
+	if amount < 0 {
+		return MinorMoney{}, ErrMoneyNegative
+	}
+	return MinorMoney{
+		amount:   amount,
+		currency: unit,
+	}, nil
+}
+
+// NewMinorMoneyFromMoney converts given money to minor units.
+//
+// Returned error could be ErrPrecisionLoss if money has more decimal places than its currency allows,
+// or ErrOverflow if the amount of minor units does not fit in an int64.
+func NewMinorMoneyFromMoney(m Money) (MinorMoney, error) {
+	precision, err := GetCurrencyPrecision(m.currency)
+	if err != nil {
+		return MinorMoney{}, err
+	}
+
+	shifted := m.amount.Shift(int32(precision))
+	if !shifted.IsInteger() {
+		return MinorMoney{}, ErrPrecisionLoss
+	}
+	amount := shifted.BigInt()
+	if !amount.IsInt64() {
+		return MinorMoney{}, ErrOverflow
+	}
+
+	return MinorMoney{
+		amount:   amount.Int64(),
+		currency: m.currency,
+	}, nil
+}
+
+// ToMoney converts current minor money back to Money, without any loss.
+func (m MinorMoney) ToMoney() Money {
+	precision, _ := GetCurrencyPrecision(m.currency)
+	return Money{
+		amount:   decimal.New(m.amount, -int32(precision)),
+		currency: m.currency,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (m MinorMoney) String() string {
+	return fmt.Sprintf("MinorMoney{%d, %s}", m.amount, m.currency)
+}
+
+// GetAmount returns amount of minor units
+func (m MinorMoney) GetAmount() int64 {
+	return m.amount
+}
+
+// GetCurrency returns current minor money's currency
+func (m MinorMoney) GetCurrency() string {
+	return m.currency
+}
+
+// SameKind checks if other's currency is identical to current minor money currency.
+func (m MinorMoney) SameKind(other MinorMoney) bool {
+	return m.currency == other.currency
+}
+
+// LessThan checks if other's amount is greater than m's amount
+// AND checking same currency included
+func (m MinorMoney) LessThan(other MinorMoney) bool {
+	return m.SameKind(other) && m.amount < other.amount
+}
+
+// Equal checks if other's amount is equal to m's amount
+func (m MinorMoney) Equal(other MinorMoney) bool {
+	return m.SameKind(other) && m.amount == other.amount
+}
+
+// Add adds two minor money amount together.
+// If returned error is not nil, it could be a *CurrencyMismatchError or ErrOverflow
+func (m MinorMoney) Add(other MinorMoney) (MinorMoney, error) {
+	if !m.SameKind(other) {
+		return MinorMoney{}, &CurrencyMismatchError{Left: m.currency, Right: other.currency}
+	}
+	sum := m.amount + other.amount
+	if (sum > m.amount) != (other.amount > 0) {
+		return MinorMoney{}, ErrOverflow
+	}
+	return MinorMoney{sum, m.currency}, nil
+}
+
+// Sub subtracts given other from current minor money.
+// If returned error is not nil, it could be a *CurrencyMismatchError or ErrOverflow
+func (m MinorMoney) Sub(other MinorMoney) (MinorMoney, error) {
+	if !m.SameKind(other) {
+		return MinorMoney{}, &CurrencyMismatchError{Left: m.currency, Right: other.currency}
+	}
+	diff := m.amount - other.amount
+	if (diff < m.amount) != (other.amount > 0) {
+		return MinorMoney{}, ErrOverflow
+	}
+	return MinorMoney{diff, m.currency}, nil
+}
+
+// Mul multiplies current minor money with given factor.
+// If returned error is not nil, it is ErrOverflow
+func (m MinorMoney) Mul(factor int64) (MinorMoney, error) {
+	if m.amount == 0 || factor == 0 {
+		return MinorMoney{0, m.currency}, nil
+	}
+	product := m.amount * factor
+	if product/factor != m.amount || (m.amount == -1 && factor == math.MinInt64) || (factor == -1 && m.amount == math.MinInt64) {
+		return MinorMoney{}, ErrOverflow
+	}
+	return MinorMoney{product, m.currency}, nil
+}
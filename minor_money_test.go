@@ -0,0 +1,112 @@
+package goprices
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestNewMinorMoneyFromMoney(t *testing.T) {
+	type testUnit struct {
+		amount   string
+		currency string
+		expected int64
+		err      error
+	}
+	testCases := []testUnit{
+		{"12.5", USD, 1250, nil},
+		{"1980", JPY, 1980, nil},
+		{"1.234", KWD, 1234, nil},
+		{"12.505", USD, 0, ErrPrecisionLoss},
+		{"100000000000000000", USD, 0, ErrOverflow},
+	}
+
+	for index, test := range testCases {
+		m, err := NewMoneyFromDecimal(decimal.RequireFromString(test.amount), test.currency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		minor, err := NewMinorMoneyFromMoney(*m)
+		if err != test.err {
+			t.Fatalf("Error at index: %d, expected error: %v, got: %v", index, test.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if minor.GetAmount() != test.expected {
+			t.Fatalf("Error at index: %d, expected: %d, got: %d", index, test.expected, minor.GetAmount())
+		}
+		if !minor.ToMoney().Equal(*m) {
+			t.Fatalf("Error at index: %d, lossy conversion: %s", index, minor.ToMoney())
+		}
+	}
+}
+
+func TestMinorMoneyOverflow(t *testing.T) {
+	m, err := NewMinorMoney(math.MaxInt64, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	one, err := NewMinorMoney(1, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Add(one); err != ErrOverflow {
+		t.Fatalf("expected ErrOverflow, got: %v", err)
+	}
+	if _, err := m.Mul(2); err != ErrOverflow {
+		t.Fatalf("expected ErrOverflow, got: %v", err)
+	}
+	if _, err := one.Sub(m); err != nil {
+		t.Fatal(err)
+	}
+
+	eur, err := NewMinorMoney(1, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := one.Add(eur); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+}
+
+func BenchmarkMoneyAdd(b *testing.B) {
+	m, _ := NewMoney(12.5, USD)
+	step, _ := NewMoney(0.01, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, _ = m.Add(*step)
+	}
+}
+
+func BenchmarkMinorMoneyAdd(b *testing.B) {
+	m, _ := NewMinorMoney(1250, USD)
+	step, _ := NewMinorMoney(1, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, _ = m.Add(step)
+	}
+}
+
+func BenchmarkMoneyMul(b *testing.B) {
+	m, _ := NewMoney(12.5, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Mul(3)
+	}
+}
+
+func BenchmarkMinorMoneyMul(b *testing.B) {
+	m, _ := NewMinorMoney(1250, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Mul(3)
+	}
+}
@@ -2,6 +2,7 @@ package goprices
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/site-name/decimal"
 )
@@ -14,19 +15,31 @@ type Money struct {
 
 var _ MoneyInterface[Money] = (*Money)(nil)
 
-// NewMoney returns new Money object
+// NewMoney returns new Money object.
+// Negative amounts are rejected with ErrMoneyNegative, use NewSignedMoney for refunds, credit notes, ledgers...
 func NewMoney(amount float64, currency string) (*Money, error) {
 	return NewMoneyFromDecimal(decimal.NewFromFloat(amount), currency)
 }
 
+// NewMoneyFromDecimal is like NewMoney but takes a decimal amount.
 func NewMoneyFromDecimal(amount decimal.Decimal, currency string) (*Money, error) {
+	if amount.IsNegative() {
+		return nil, ErrMoneyNegative
+	}
+	return NewSignedMoneyFromDecimal(amount, currency)
+}
+
+// NewSignedMoney is like NewMoney but accepts negative amounts.
+func NewSignedMoney(amount float64, currency string) (*Money, error) {
+	return NewSignedMoneyFromDecimal(decimal.NewFromFloat(amount), currency)
+}
+
+// NewSignedMoneyFromDecimal is like NewMoneyFromDecimal but accepts negative amounts.
+func NewSignedMoneyFromDecimal(amount decimal.Decimal, currency string) (*Money, error) {
 	unit, err := validateCurrency(currency)
 	if err != nil {
 		return nil, err
 	}
-	if amount.LessThan(decimal.Zero) {
-		return nil, ErrMoneyNegative
-	}
 	return &Money{
 		amount:   amount,
 		currency: unit,
@@ -71,34 +84,141 @@ func (m Money) LessThanOrEqual(other Money) bool {
 	return m.LessThan(other) || m.Equal(other)
 }
 
+// GreaterThan checks if m's amount is greater than other's amount
+// AND checking same currency included
+func (m Money) GreaterThan(other Money) bool {
+	return m.SameKind(other) && m.amount.GreaterThan(other.amount)
+}
+
+// GreaterThanOrEqual checks if m's amount is greater than or equal to other's amount
+func (m Money) GreaterThanOrEqual(other Money) bool {
+	return m.SameKind(other) && m.amount.GreaterThanOrEqual(other.amount)
+}
+
+// Compare returns -1, 0 or +1 if m is less than, equal to or greater than other.
+// Unlike LessThan, Equal..., a currency mismatch is reported as a *CurrencyMismatchError
+func (m Money) Compare(other Money) (int, error) {
+	if !m.SameKind(other) {
+		return 0, &CurrencyMismatchError{Left: m.currency, Right: other.currency}
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
 // Mul multiplty current money with the givent other.
 //
-// NOTE: other must be either ints or floats or Decimal
+// NOTE: other is converted to decimal, use MulDecimal to avoid binary float errors.
 func (m Money) Mul(other float64) Money {
+	return m.MulDecimal(decimal.NewFromFloat(other))
+}
+
+// MulDecimal multiplies current money with given other, without any precision loss.
+func (m Money) MulDecimal(other decimal.Decimal) Money {
 	return Money{
 		currency: m.currency,
-		amount:   m.amount.Mul(decimal.NewFromFloat(other)),
+		amount:   m.amount.Mul(other),
 	}
 }
 
+// MulRat multiplies current money with given exact fraction, e.g: big.NewRat(1, 3).
+// Result is exact when it has a finite number of decimal places, e.g: 1.005 * 1/1 => 1.005 or 3 * 1/3 => 1,
+// otherwise it is rounded half up to currency precision, e.g: 10 USD * 2/3 => 6.67 USD.
+//
+// NOTE: other must not be nil.
+func (m Money) MulRat(other *big.Rat) Money {
+	product := new(big.Rat).Mul(m.amount.Rat(), other)
+	amount, ok := exactDecimal(product)
+	if !ok {
+		amount = decimal.NewFromBigInt(product.Num(), 0).DivRound(decimal.NewFromBigInt(product.Denom(), 0), m.divisionPrecision())
+	}
+	return Money{
+		currency: m.currency,
+		amount:   amount,
+	}
+}
+
+// exactDecimal returns given fraction as a decimal, if its denominator has no prime factors but 2 and 5
+func exactDecimal(r *big.Rat) (decimal.Decimal, bool) {
+	denom := new(big.Int).Set(r.Denom())
+	twos := denom.TrailingZeroBits()
+	denom.Rsh(denom, twos)
+
+	var fives uint
+	five := big.NewInt(5)
+	quo, rem := new(big.Int), new(big.Int)
+	for {
+		quo.QuoRem(denom, five, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		denom.Set(quo)
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return decimal.Decimal{}, false
+	}
+
+	places := twos
+	if fives > places {
+		places = fives
+	}
+	// numerator * 10^places is a multiple of denominator
+	scaled := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled.Mul(scaled, r.Num()).Quo(scaled, r.Denom())
+	return decimal.NewFromBigInt(scaled, -int32(places)), true
+}
+
 // TrueDiv divides money with the given other.
 //
-// NOTE: other must be either ints or uints or floats or Decimal or Money
+// NOTE: other is converted to decimal, use DivDecimal to avoid binary float errors.
 func (m Money) TrueDiv(other float64) Money {
 	return Money{
 		currency: m.currency,
-		amount:   m.amount.DivRound(decimal.NewFromFloat(other), int32(currencies[m.currency].Fraction)),
+		amount:   m.amount.DivRound(decimal.NewFromFloat(other), m.divisionPrecision()),
+	}
+}
+
+// DivDecimal divides money with given other. Result is rounded half up to currency precision.
+// If returned error is not nil, it is ErrDivisorZero
+func (m Money) DivDecimal(other decimal.Decimal) (*Money, error) {
+	return ptr(m.divDecimal(other))
+}
+
+func (m Money) divDecimal(other decimal.Decimal) (Money, error) {
+	if other.IsZero() {
+		return Money{}, ErrDivisorZero
+	}
+	return Money{
+		currency: m.currency,
+		amount:   m.amount.DivRound(other, m.divisionPrecision()),
+	}, nil
+}
+
+// divisionPrecision returns number of decimal places kept by divisions
+func (m Money) divisionPrecision() int32 {
+	precision, err := GetCurrencyPrecision(m.currency)
+	if err != nil {
+		return int32(decimal.DivisionPrecision)
 	}
+	return int32(precision)
 }
 
 // Add adds two money amount together, returns new money.
-// If returned error is not nil, it could be ErrNotSameCurrency
+// If returned error is not nil, it could be a *CurrencyMismatchError
 func (m Money) Add(other Money) (*Money, error) {
+	return ptr(m.add(other))
+}
+
+// MustAdd is like Add but returns a value and panics if error occurs.
+func (m Money) MustAdd(other Money) Money {
+	return must(m.add(other))
+}
+
+func (m Money) add(other Money) (Money, error) {
 	if !m.SameKind(other) {
-		return nil, ErrNotSameCurrency
+		return Money{}, &CurrencyMismatchError{Left: m.currency, Right: other.currency}
 	}
 
-	return &Money{
+	return Money{
 		m.amount.Add(other.amount),
 		m.currency,
 	}, nil
@@ -112,24 +232,61 @@ func (m Money) Neg() Money {
 	}
 }
 
+// IsNegative checks if current money's amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+// IsZero checks if current money's amount is zero
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// IsPositive checks if current money's amount is greater than zero
+func (m Money) IsPositive() bool {
+	return m.amount.IsPositive()
+}
+
+// Abs returns |m|
+func (m Money) Abs() Money {
+	return Money{
+		amount:   m.amount.Abs(),
+		currency: m.currency,
+	}
+}
+
 // Sub subtracts current money to given other.
-// If error is not nil, it could be ErrNotSameCurrency
+// If error is not nil, it could be a *CurrencyMismatchError
 func (m Money) Sub(other Money) (*Money, error) {
-	return m.Add(other.Neg())
+	return ptr(m.add(other.Neg()))
+}
+
+// MustSub is like Sub but returns a value and panics if error occurs.
+func (m Money) MustSub(other Money) Money {
+	return must(m.add(other.Neg()))
 }
 
 // Return a copy of the object with its amount quantized.
 // NOTE: if exp < 0, default will be used
 func (m Money) Quantize(round Rounding, exp int) (*Money, error) {
+	return ptr(m.quantize(round, exp))
+}
+
+// MustQuantize is like Quantize but returns a value and panics if error occurs.
+func (m Money) MustQuantize(round Rounding, exp int) Money {
+	return must(m.quantize(round, exp))
+}
+
+func (m Money) quantize(round Rounding, exp int) (Money, error) {
 	if exp < 0 {
 		var err error
 		exp, err = GetCurrencyPrecision(m.currency)
 		if err != nil {
-			return nil, err
+			return Money{}, err
 		}
 	}
 
-	money := &Money{
+	money := Money{
 		currency: m.currency,
 	}
 	switch round {
@@ -143,35 +300,53 @@ func (m Money) Quantize(round Rounding, exp int) (*Money, error) {
 		money.amount = m.amount.RoundFloor(int32(exp))
 
 	default:
-		return nil, ErrInvalidRounding
+		return Money{}, ErrInvalidRounding
 	}
 
 	return money, nil
 }
 
 // Apply a fixed discount to Money type.
-func (m Money) fixedDiscount(discount Money) (*Money, error) {
-	sub, err := m.Sub(discount)
+func (m Money) fixedDiscount(discount Money) (Money, error) {
+	sub, err := m.add(discount.Neg())
 	if err != nil {
-		return nil, err
+		return Money{}, err
 	}
 
 	if sub.amount.GreaterThan(decimal.Zero) {
 		return sub, nil
 	}
 
-	return &Money{
+	return Money{
 		currency: m.currency,
 		amount:   decimal.Zero,
 	}, nil
 }
 
-func (m Money) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (*Money, error) {
-	mul := m.Mul(fraction.InexactFloat64())
-	quantized, err := mul.Quantize(rounding, -1)
+func (m Money) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (Money, error) {
+	mul := m.MulDecimal(fraction)
+	quantized, err := mul.quantize(rounding, -1)
 	if err != nil {
-		return nil, err
+		return Money{}, err
+	}
+
+	return m.fixedDiscount(quantized)
+}
+
+// cappedFractionalDiscount applies a fractional discount to m, the discounted amount never exceeds max.
+func (m Money) cappedFractionalDiscount(fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (Money, error) {
+	if !m.SameKind(max) {
+		return Money{}, &CurrencyMismatchError{Left: m.currency, Right: max.currency}
+	}
+
+	mul := m.MulDecimal(fraction)
+	discount, err := mul.quantize(rounding, -1)
+	if err != nil {
+		return Money{}, err
+	}
+	if max.LessThan(discount) {
+		discount = max
 	}
 
-	return m.fixedDiscount(*quantized)
+	return m.fixedDiscount(discount)
 }
@@ -1,44 +1,112 @@
 package goprices
 
 import (
-	"fmt"
+	"math/big"
 
 	"github.com/site-name/decimal"
 )
 
-// MoneyRange has start and stop ends
+// MoneyRange has start and stop ends. Each end is either Inclusive, Exclusive or Unbounded, see Bound
 type MoneyRange struct {
-	start Money
-	stop  Money
+	start      Money
+	stop       Money
+	startBound Bound
+	stopBound  Bound
 }
 
 var _ MoneyInterface[MoneyRange] = (*MoneyRange)(nil)
 
-// NewMoneyRange returns a new range. If start is greater than stop or start and stop have different
-// currencies, return nil and non nil error
+// NewMoneyRange returns a new closed range [start, stop]. If start is greater than stop or start and stop have different
+// currencies, return nil and non nil error.
+//
+// NOTE: start equal to stop is allowed, the result is a degenerate range holding a single value.
+// Negative ends are rejected with ErrMoneyNegative, use NewSignedMoneyRange to allow them.
 func NewMoneyRange(start, stop Money) (*MoneyRange, error) {
+	return NewMoneyRangeWithBounds(start, Inclusive, stop, Inclusive)
+}
+
+// NewSignedMoneyRange is like NewMoneyRange but accepts negative ends.
+func NewSignedMoneyRange(start, stop Money) (*MoneyRange, error) {
+	return ptr(newMoneyRange(start, stop, Inclusive, Inclusive))
+}
+
+// NewMoneyRangeWithBounds returns a new range with given bounds, e.g:
+//
+//	NewMoneyRangeWithBounds(twenty, Inclusive, hundred, Exclusive) => [20, 100)
+//
+// The value of an Unbounded end is ignored, but its currency which can be left empty.
+// start equal to stop is allowed only if both ends are Inclusive, otherwise the range would be empty.
+// Negative bounded ends are rejected with ErrMoneyNegative, use WithBounds on a signed range to allow them.
+func NewMoneyRangeWithBounds(start Money, startBound Bound, stop Money, stopBound Bound) (*MoneyRange, error) {
+	if (startBound != Unbounded && start.IsNegative()) || (stopBound != Unbounded && stop.IsNegative()) {
+		return nil, ErrMoneyNegative
+	}
+	return ptr(newMoneyRange(start, stop, startBound, stopBound))
+}
+
+// NewMoneyRangeFrom returns a range without stop, e.g: "from $20" is [20, +inf)
+func NewMoneyRangeFrom(start Money, bound Bound) (*MoneyRange, error) {
+	return NewMoneyRangeWithBounds(start, bound, Money{currency: start.currency}, Unbounded)
+}
+
+// NewMoneyRangeTo returns a range without start, e.g: "under $100" is (-inf, 100)
+func NewMoneyRangeTo(stop Money, bound Bound) (*MoneyRange, error) {
+	return NewMoneyRangeWithBounds(Money{currency: stop.currency}, Unbounded, stop, bound)
+}
+
+func newMoneyRange(start, stop Money, startBound, stopBound Bound) (MoneyRange, error) {
+	if !startBound.valid() || !stopBound.valid() {
+		return MoneyRange{}, ErrInvalidBound
+	}
+	if startBound == Unbounded && start.currency == "" {
+		start.currency = stop.currency
+	}
+	if stopBound == Unbounded && stop.currency == "" {
+		stop.currency = start.currency
+	}
+
 	startUnit, err := validateCurrency(start.currency)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
 	stopUnit, err := validateCurrency(stop.currency)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
 	if startUnit != stopUnit {
-		return nil, ErrNotSameCurrency
+		return MoneyRange{}, &CurrencyMismatchError{Left: startUnit, Right: stopUnit}
 	}
-	if start.amount.LessThan(decimal.Zero) || stop.amount.LessThan(decimal.Zero) {
-		return nil, ErrMoneyNegative
+
+	res := MoneyRange{startBound: startBound, stopBound: stopBound}.withEnds(start, stop)
+	if empty, _ := isEmpty(res.startEnd(), res.stopEnd(), Money.Compare); empty {
+		return MoneyRange{}, &InvalidRangeError{Start: start, Stop: stop}
+	}
+	return res, nil
+}
+
+// withEnds returns a range with given ends and bounds of current money range.
+// Values of unbounded ends are reset to zero.
+func (m MoneyRange) withEnds(start, stop Money) MoneyRange {
+	if m.startBound == Unbounded {
+		start = Money{decimal.Zero, start.currency}
+	}
+	if m.stopBound == Unbounded {
+		stop = Money{decimal.Zero, stop.currency}
 	}
-	if stop.LessThanOrEqual(start) {
-		return nil, ErrStopLessThanStart
+	return MoneyRange{
+		start:      start,
+		stop:       stop,
+		startBound: m.startBound,
+		stopBound:  m.stopBound,
 	}
+}
+
+func (m MoneyRange) startEnd() rangeEnd[Money] {
+	return rangeEnd[Money]{m.start, m.startBound}
+}
 
-	return &MoneyRange{
-		start: start,
-		stop:  stop,
-	}, nil
+func (m MoneyRange) stopEnd() rangeEnd[Money] {
+	return rangeEnd[Money]{m.stop, m.stopBound}
 }
 
 func NewMoneyRangeFromFloats(start, stop float64, currency string) (*MoneyRange, error) {
@@ -87,8 +155,32 @@ func (m MoneyRange) GetStop() Money {
 	return m.stop
 }
 
+// StartBound returns bound of current money range's start
+func (m MoneyRange) StartBound() Bound {
+	return m.startBound
+}
+
+// StopBound returns bound of current money range's stop
+func (m MoneyRange) StopBound() Bound {
+	return m.stopBound
+}
+
+// IsBounded checks if current money range has both start and stop
+func (m MoneyRange) IsBounded() bool {
+	return m.startBound != Unbounded && m.stopBound != Unbounded
+}
+
+// WithBounds returns a copy of current money range with given bounds.
+// An end becoming bounded after being Unbounded has zero value.
+func (m MoneyRange) WithBounds(startBound, stopBound Bound) (*MoneyRange, error) {
+	return ptr(newMoneyRange(m.start, m.stop, startBound, stopBound))
+}
+
+// String implements fmt.Stringer interface, using interval notation, e.g:
+//
+//	MoneyRange[Money{20, USD}, +inf)
 func (m MoneyRange) String() string {
-	return fmt.Sprintf("MoneyRange{%s, %s}", m.start.String(), m.stop.String())
+	return "MoneyRange" + formatRange(m.startEnd(), m.stopEnd())
 }
 
 // GetCurrency returns current money range's currency
@@ -97,69 +189,168 @@ func (m MoneyRange) GetCurrency() string {
 }
 
 // Add adds a Value to current.
+// When other is a MoneyRange, an end of the result is Unbounded or Exclusive if any of the added ends is.
 //
 // other must be either Money or MoneyRange
 func (m MoneyRange) Add(other any) (*MoneyRange, error) {
+	return ptr(m.add(other))
+}
+
+// MustAdd is like Add but returns a value and panics if error occurs.
+func (m MoneyRange) MustAdd(other any) MoneyRange {
+	return must(m.add(other))
+}
+
+func (m MoneyRange) add(other any) (MoneyRange, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return MoneyRange{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money:
-		start, err := m.start.Add(v)
+		start, err := m.start.add(v)
 		if err != nil {
-			return nil, err
+			return MoneyRange{}, err
 		}
-		stop, err := m.stop.Add(v)
+		stop, err := m.stop.add(v)
 		if err != nil {
-			return nil, err
+			return MoneyRange{}, err
 		}
-		return &MoneyRange{*start, *stop}, nil
+		return m.withEnds(start, stop), nil
 
 	case MoneyRange:
-		start, err := m.start.Add(v.start)
+		start, err := m.start.add(v.start)
 		if err != nil {
-			return nil, err
+			return MoneyRange{}, err
 		}
-		stop, err := m.stop.Add(v.stop)
+		stop, err := m.stop.add(v.stop)
 		if err != nil {
-			return nil, err
+			return MoneyRange{}, err
+		}
+		bounds := MoneyRange{
+			startBound: combineBounds(m.startBound, v.startBound),
+			stopBound:  combineBounds(m.stopBound, v.stopBound),
 		}
-		return &MoneyRange{*start, *stop}, nil
+		return bounds.withEnds(start, stop), nil
 
 	default:
-		return nil, ErrUnknownType
+		return MoneyRange{}, ErrUnknownType
 	}
 }
 
 // Sub subtracts current money to given `other`.
-// `other` can be either `Money` or `MoneyRange`
+// `other` can be either `Money` or `MoneyRange`.
+// Ranges are subtracted end by end (start - start, stop - stop), an end of the result
+// is unbounded or exclusive if any of the corresponding ends is, e.g:
+//
+//	[10, 20] - [1, 2) => [9, 18)
 func (m MoneyRange) Sub(other any) (*MoneyRange, error) {
+	return ptr(m.sub(other))
+}
+
+// MustSub is like Sub but returns a value and panics if error occurs.
+func (m MoneyRange) MustSub(other any) MoneyRange {
+	return must(m.sub(other))
+}
+
+func (m MoneyRange) sub(other any) (MoneyRange, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return MoneyRange{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money:
-		return m.Add(v.Neg())
+		return m.add(v.Neg())
 	case MoneyRange:
-		return m.Add(v.Neg())
+		start, err := m.start.add(v.start.Neg())
+		if err != nil {
+			return MoneyRange{}, err
+		}
+		stop, err := m.stop.add(v.stop.Neg())
+		if err != nil {
+			return MoneyRange{}, err
+		}
+		bounds := MoneyRange{
+			startBound: combineBounds(m.startBound, v.startBound),
+			stopBound:  combineBounds(m.stopBound, v.stopBound),
+		}
+		return bounds.withEnds(start, stop), nil
 
 	default:
-		return nil, ErrUnknownType
+		return MoneyRange{}, ErrUnknownType
 	}
 }
 
+// Neg returns the range of negated values of current money range, ends are swapped with their bounds, e.g:
+//
+//	[20, +inf) => (-inf, -20]
 func (m MoneyRange) Neg() MoneyRange {
-	return MoneyRange{
-		start: m.start.Neg(),
-		stop:  m.stop.Neg(),
+	return MoneyRange{startBound: m.stopBound, stopBound: m.startBound}.withEnds(m.stop.Neg(), m.start.Neg())
+}
+
+// IsNegative checks if current money range holds any value less than zero
+func (m MoneyRange) IsNegative() bool {
+	return m.startBound == Unbounded || m.start.IsNegative() || (m.stopBound != Unbounded && m.stop.IsNegative())
+}
+
+// IsZero checks if both start and stop of current money range are zero
+func (m MoneyRange) IsZero() bool {
+	return m.IsBounded() && m.start.IsZero() && m.stop.IsZero()
+}
+
+// IsPositive checks if all values of current money range are greater than zero
+func (m MoneyRange) IsPositive() bool {
+	return m.startBound != Unbounded &&
+		(m.start.IsPositive() || (m.start.IsZero() && m.startBound == Exclusive)) &&
+		(m.stopBound == Unbounded || m.stop.IsPositive())
+}
+
+// Abs returns the range of absolute values of current money range, e.g:
+//
+//	[-5, 3] => [0, 5]
+//	[-5, -3) => (3, 5]
+//	(-inf, -3] => [3, +inf)
+func (m MoneyRange) Abs() MoneyRange {
+	start, stop := absInterval(m.startEnd(), m.stopEnd())
+	return MoneyRange{start.value, stop.value, start.bound, stop.bound}
+}
+
+// absInterval returns ends of the range of absolute values of the range from start to stop
+func absInterval(start, stop rangeEnd[Money]) (rangeEnd[Money], rangeEnd[Money]) {
+	zero := Money{decimal.Zero, start.value.currency}
+	abs := func(end rangeEnd[Money]) rangeEnd[Money] {
+		return rangeEnd[Money]{end.value.Abs(), end.bound}
+	}
+
+	switch {
+	case start.bound != Unbounded && !start.value.IsNegative():
+		return start, stop
+	case stop.bound != Unbounded && !stop.value.IsPositive():
+		return abs(stop), abs(start)
+	case start.bound == Unbounded || stop.bound == Unbounded:
+		return rangeEnd[Money]{zero, Inclusive}, rangeEnd[Money]{zero, Unbounded}
+	}
+
+	// start < 0 < stop
+	switch c := start.value.amount.Abs().Cmp(stop.value.amount); {
+	case c < 0:
+		return rangeEnd[Money]{zero, Inclusive}, stop
+	case c > 0:
+		return rangeEnd[Money]{zero, Inclusive}, abs(start)
+	default:
+		// |start| == stop, it is included if any of them is
+		bound := stop.bound
+		if start.bound < bound {
+			bound = start.bound
+		}
+		return rangeEnd[Money]{zero, Inclusive}, rangeEnd[Money]{stop.value, bound}
 	}
 }
 
-// Equal Checks if two MoneyRange are equal both `start`, `stop` and `currency`
+// Equal Checks if two MoneyRange are equal both `start`, `stop`, their bounds and `currency`
 func (m MoneyRange) Equal(other MoneyRange) bool {
-	return m.start.Equal(other.start) && m.stop.Equal(other.stop)
+	return m.start.Equal(other.start) && m.stop.Equal(other.stop) &&
+		m.startBound == other.startBound && m.stopBound == other.stopBound
 }
 
 // LessThan compares currenct money range to given other
@@ -172,29 +363,42 @@ func (m MoneyRange) LessThanOrEqual(other MoneyRange) bool {
 	return m.LessThan(other) || m.Equal(other)
 }
 
-// Contains check if a Money is between this MoneyRange's two ends
+// IsDegenerate checks if start and stop of current money range are equal and inclusive,
+// meaning the range holds a single value only
+func (m MoneyRange) IsDegenerate() bool {
+	return m.startBound == Inclusive && m.stopBound == Inclusive && m.start.Equal(m.stop)
+}
+
+// Contains check if a Money is between this MoneyRange's two ends, respecting their bounds
 func (m MoneyRange) Contains(value Money) bool {
-	return m.start.LessThanOrEqual(value) && value.LessThanOrEqual(m.stop)
+	return contains(m.startEnd(), m.stopEnd(), value, Money.Compare)
 }
 
 // Return a copy of the range with start and stop quantized.
 // NOTE: if exp < 0 the system will use default
 func (m MoneyRange) Quantize(round Rounding, exp int) (*MoneyRange, error) {
-	start, err := m.start.Quantize(round, exp)
+	return ptr(m.quantize(round, exp))
+}
+
+// MustQuantize is like Quantize but returns a value and panics if error occurs.
+func (m MoneyRange) MustQuantize(round Rounding, exp int) MoneyRange {
+	return must(m.quantize(round, exp))
+}
+
+func (m MoneyRange) quantize(round Rounding, exp int) (MoneyRange, error) {
+	start, err := m.start.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
-	stop, err := m.stop.Quantize(round, exp)
+	stop, err := m.stop.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
-	return &MoneyRange{
-		start: *start,
-		stop:  *stop,
-	}, nil
+	return m.withEnds(start, stop), nil
 }
 
 // Replace replace start and stop of currenct MoneyRagne With two given `start` and `stop` respectively.
+// Bounds are kept.
 func (m MoneyRange) Replace(start, stop *Money) (*MoneyRange, error) {
 	if start == nil {
 		start = &m.start
@@ -202,46 +406,86 @@ func (m MoneyRange) Replace(start, stop *Money) (*MoneyRange, error) {
 	if stop == nil {
 		stop = &m.stop
 	}
-	return NewMoneyRange(*start, *stop)
+	return NewMoneyRangeWithBounds(*start, m.startBound, *stop, m.stopBound)
 }
 
 // Apply a fixed discount to MoneyRange.
-func (m MoneyRange) fixedDiscount(discount Money) (*MoneyRange, error) {
+func (m MoneyRange) fixedDiscount(discount Money) (MoneyRange, error) {
 	baseStart, err := m.start.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
 	baseStop, err := m.stop.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return MoneyRange{}, err
 	}
-	return NewMoneyRange(*baseStart, *baseStop)
+	return newMoneyRange(baseStart, baseStop, m.startBound, m.stopBound)
 }
 
+// Mul multiplies both ends of current money range with given other.
+//
+// NOTE: other is converted to decimal, use MulDecimal to avoid binary float errors.
 func (m MoneyRange) Mul(other float64) MoneyRange {
-	return MoneyRange{
-		start: m.start.Mul(other),
-		stop:  m.start.Mul(other),
-	}
+	return m.MulDecimal(decimal.NewFromFloat(other))
+}
+
+// MulDecimal multiplies both ends of current money range with given other, without any precision loss.
+func (m MoneyRange) MulDecimal(other decimal.Decimal) MoneyRange {
+	return m.withEnds(m.start.MulDecimal(other), m.stop.MulDecimal(other))
+}
+
+// MulRat multiplies both ends of current money range with given exact fraction.
+// See Money.MulRat
+func (m MoneyRange) MulRat(other *big.Rat) MoneyRange {
+	return m.withEnds(m.start.MulRat(other), m.stop.MulRat(other))
 }
 
+// TrueDiv divides both ends of current money range with given other.
+//
+// NOTE: other is converted to decimal, use DivDecimal to avoid binary float errors.
 func (m MoneyRange) TrueDiv(other float64) MoneyRange {
-	return MoneyRange{
-		start: m.start.TrueDiv(other),
-		stop:  m.start.TrueDiv(other),
+	return m.withEnds(m.start.TrueDiv(other), m.stop.TrueDiv(other))
+}
+
+// DivDecimal divides both ends of current money range with given other.
+// If returned error is not nil, it is ErrDivisorZero
+func (m MoneyRange) DivDecimal(other decimal.Decimal) (*MoneyRange, error) {
+	start, err := m.start.divDecimal(other)
+	if err != nil {
+		return nil, err
 	}
+	stop, err := m.stop.divDecimal(other)
+	if err != nil {
+		return nil, err
+	}
+	res := m.withEnds(start, stop)
+	return &res, nil
 }
 
-func (m MoneyRange) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (*MoneyRange, error) {
+func (m MoneyRange) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (MoneyRange, error) {
 	start, err1 := m.start.fractionalDiscount(fraction, fromGross, rounding)
 	if err1 != nil {
-		return nil, err1
+		return MoneyRange{}, err1
 	}
 
 	stop, err2 := m.stop.fractionalDiscount(fraction, fromGross, rounding)
 	if err2 != nil {
-		return nil, err2
+		return MoneyRange{}, err2
+	}
+
+	return newMoneyRange(start, stop, m.startBound, m.stopBound)
+}
+
+func (m MoneyRange) cappedFractionalDiscount(fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (MoneyRange, error) {
+	start, err := m.start.cappedFractionalDiscount(fraction, max, fromGross, rounding)
+	if err != nil {
+		return MoneyRange{}, err
+	}
+
+	stop, err := m.stop.cappedFractionalDiscount(fraction, max, fromGross, rounding)
+	if err != nil {
+		return MoneyRange{}, err
 	}
 
-	return NewMoneyRange(*start, *stop)
+	return newMoneyRange(start, stop, m.startBound, m.stopBound)
 }
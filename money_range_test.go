@@ -1,6 +1,7 @@
 package goprices
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -40,3 +41,51 @@ func TestMoneyRangeSub(t *testing.T) {
 	}
 	fmt.Println(res)
 }
+
+func TestNewMoneyRangeDegenerate(t *testing.T) {
+	moneyRange, err := NewMoneyRangeFromFloats(20, 20, USD)
+	if err != nil {
+		t.Fatalf("error create degenerate money range: %v", err)
+	}
+	if !moneyRange.IsDegenerate() {
+		t.Fatalf("expected degenerate money range, got: %s", moneyRange)
+	}
+
+	_, err = NewMoneyRangeFromFloats(21, 20, USD)
+	if !errors.Is(err, ErrStopLessThanStart) {
+		t.Fatalf("expected ErrStopLessThanStart, got: %v", err)
+	}
+}
+
+func TestSignedMoneyRange(t *testing.T) {
+	type testUnit struct {
+		start, stop        float64
+		absStart, absStop  float64
+		negative, positive bool
+	}
+	for index, unit := range []testUnit{
+		{2, 5, 2, 5, false, true},
+		{0, 5, 0, 5, false, false},
+		{-5, 3, 0, 5, true, false},
+		{-3, 5, 0, 5, true, false},
+		{-5, -3, 3, 5, true, false},
+	} {
+		start := *must(NewSignedMoney(unit.start, USD))
+		stop := *must(NewSignedMoney(unit.stop, USD))
+		if _, err := NewMoneyRange(start, stop); unit.negative != errors.Is(err, ErrMoneyNegative) {
+			t.Fatalf("Error at index: %d, unexpected error: %v", index, err)
+		}
+
+		moneyRange, err := NewSignedMoneyRange(start, stop)
+		if err != nil {
+			t.Fatalf("Error at index: %d, %v", index, err)
+		}
+		if moneyRange.IsNegative() != unit.negative || moneyRange.IsPositive() != unit.positive {
+			t.Fatalf("Error at index: %d, unexpected sign helpers result for %s", index, moneyRange)
+		}
+		expected := must(NewMoneyRangeFromFloats(unit.absStart, unit.absStop, USD))
+		if abs := moneyRange.Abs(); !abs.Equal(*expected) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, expected, abs)
+		}
+	}
+}
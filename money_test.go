@@ -1,7 +1,9 @@
 package goprices
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/site-name/decimal"
@@ -154,3 +156,198 @@ func TestNeg(t *testing.T) {
 
 	fmt.Println(neg)
 }
+
+func TestMustAdd(t *testing.T) {
+	m1, err := NewMoney(45, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2, err := NewMoney(23, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := m1.MustAdd(*m2)
+	if !sum.amount.Equal(decimal.NewFromInt(68)) {
+		t.Fatalf("expected 68, got: %s", sum)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrNotSameCurrency) {
+			t.Fatalf("expected panic with ErrNotSameCurrency, got: %v", err)
+		}
+	}()
+	m1.MustAdd(Money{amount: decimal.NewFromInt(1), currency: EUR})
+}
+
+// BenchmarkMoneyMustAdd pairs with BenchmarkMoneyAdd; the value-returning
+// variant saves the per-call *Money allocation.
+func BenchmarkMoneyMustAdd(b *testing.B) {
+	m, _ := NewMoney(12.5, USD)
+	step, _ := NewMoney(0.01, USD)
+	value := *m
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		value = value.MustAdd(*step)
+	}
+}
+
+func BenchmarkTaxedMoneyAdd(b *testing.B) {
+	t, _ := NewTaxedMoneyFromFloats(10, 12.3, USD)
+	step, _ := NewMoney(0.01, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t, _ = t.Add(*step)
+	}
+}
+
+func BenchmarkTaxedMoneyMustAdd(b *testing.B) {
+	t, _ := NewTaxedMoneyFromFloats(10, 12.3, USD)
+	step, _ := NewMoney(0.01, USD)
+	value := *t
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		value = value.MustAdd(*step)
+	}
+}
+
+func BenchmarkMoneyQuantize(b *testing.B) {
+	m, _ := NewMoney(12.345, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Quantize(Up, 2)
+	}
+}
+
+func BenchmarkMoneyMustQuantize(b *testing.B) {
+	m, _ := NewMoney(12.345, USD)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.MustQuantize(Up, 2)
+	}
+}
+
+func TestMulDecimal(t *testing.T) {
+	m, err := NewMoneyFromDecimal(decimal.RequireFromString("100000000000000000000"), USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fraction := decimal.RequireFromString("0.12345678901234567891")
+	expected := decimal.RequireFromString("12345678901234567891")
+
+	// float64 operands can not hold that many digits
+	if m.Mul(fraction.InexactFloat64()).amount.Equal(expected) {
+		t.Fatal("expected float64 multiplication to lose precision")
+	}
+	if res := m.MulDecimal(fraction); !res.amount.Equal(expected) {
+		t.Fatalf("expected: %s, got: %s", expected, res.amount)
+	}
+}
+
+func TestMulRat(t *testing.T) {
+	m, err := NewMoney(10, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := m.MulRat(big.NewRat(2, 3))
+	if res.amount.String() != "6.67" {
+		t.Fatalf("expected 6.67, got: %s", res)
+	}
+
+	mRange, err := NewMoneyRangeFromFloats(3, 9, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resRange := mRange.MulRat(big.NewRat(1, 3))
+	if resRange.start.amount.String() != "1" || resRange.stop.amount.String() != "3" {
+		t.Fatalf("unexpected range: %s", resRange)
+	}
+
+	type testUnit struct {
+		amount   string
+		rat      *big.Rat
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{"1.005", big.NewRat(1, 1), "1.005"},
+		{"1.005", big.NewRat(1, 4), "0.25125"},
+		{"1.005", big.NewRat(2, 3), "0.67"},
+		{"-10", big.NewRat(1, 8), "-1.25"},
+		{"-10", big.NewRat(2, 3), "-6.67"},
+		{"7", big.NewRat(3, 7), "3"},
+	} {
+		money := *must(NewSignedMoneyFromDecimal(decimal.RequireFromString(unit.amount), USD))
+		if res := money.MulRat(unit.rat); res.amount.String() != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, res.amount)
+		}
+	}
+
+	taxed := must(NewTaxedMoneyFromFloats(1.005, 1.2, USD)).MulRat(big.NewRat(1, 1))
+	if taxed.net.amount.String() != "1.005" || taxed.gross.amount.String() != "1.2" {
+		t.Fatalf("unexpected taxed money: %s", taxed)
+	}
+	exactRange := must(NewMoneyRangeFromFloats(1.005, 2, USD)).MulRat(big.NewRat(1, 2))
+	if exactRange.start.amount.String() != "0.5025" || exactRange.stop.amount.String() != "1" {
+		t.Fatalf("unexpected range: %s", exactRange)
+	}
+}
+
+func TestDivDecimal(t *testing.T) {
+	m, err := NewMoney(10, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := m.DivDecimal(decimal.NewFromInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.amount.String() != "3.33" {
+		t.Fatalf("expected 3.33, got: %s", res)
+	}
+
+	_, err = m.DivDecimal(decimal.Zero)
+	if err != ErrDivisorZero {
+		t.Fatalf("expected ErrDivisorZero, got: %v", err)
+	}
+}
+
+func TestSignedMoney(t *testing.T) {
+	if _, err := NewMoney(-10, USD); !errors.Is(err, ErrMoneyNegative) {
+		t.Fatalf("expected ErrMoneyNegative, got: %v", err)
+	}
+
+	refund, err := NewSignedMoney(-10.5, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !refund.IsNegative() || refund.IsZero() || refund.IsPositive() {
+		t.Fatalf("unexpected sign helpers result for %s", refund)
+	}
+	if abs := refund.Abs(); !abs.amount.Equal(decimal.NewFromFloat(10.5)) || abs.currency != USD {
+		t.Fatalf("expected 10.5 USD, got: %s", abs)
+	}
+
+	zero := *must(NewMoney(0, USD))
+	if zero.IsNegative() || !zero.IsZero() || zero.IsPositive() {
+		t.Fatalf("unexpected sign helpers result for %s", zero)
+	}
+
+	net := *must(NewMoney(10, USD))
+	if _, err := NewTaxedMoney(net.Neg(), net.Neg()); !errors.Is(err, ErrMoneyNegative) {
+		t.Fatalf("expected ErrMoneyNegative, got: %v", err)
+	}
+	creditNote, err := NewSignedTaxedMoney(net.Neg(), refund.MustSub(net))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !creditNote.IsNegative() || !creditNote.Abs().IsPositive() {
+		t.Fatalf("unexpected sign helpers result for %s", creditNote)
+	}
+}
@@ -0,0 +1,156 @@
+package goprices
+
+import "github.com/site-name/decimal"
+
+// PriceEndingDirection decides which way a price moves to reach a psychological ending.
+type PriceEndingDirection uint8
+
+const (
+	EndingNearest PriceEndingDirection = iota // EndingNearest moves to the closest ending, ties go up
+	EndingUp                                  // EndingUp moves to the closest ending greater than or equal to the price
+	EndingDown                                // EndingDown moves to the closest ending less than or equal to the price
+)
+
+// PriceEnding describes charm pricing rule: prices are snapped to values of form
+// n * Step + Ending.
+//
+// E.g:
+//
+//	PriceEnding{Step: 1, Ending: 0.99}  => 9.99, 10.99, 11.99
+//	PriceEnding{Step: 100, Ending: 80}  => 980, 1980, 2080
+type PriceEnding struct {
+	Step      decimal.Decimal // must be greater than zero
+	Ending    decimal.Decimal // must be in range [0, Step)
+	Direction PriceEndingDirection
+}
+
+// DefaultPriceEndings contains per currency endings used by DefaultPriceEnding.
+// Currencies missing in this map get endings derived from their precisions.
+var DefaultPriceEndings = map[string]PriceEnding{
+	JPY: {Step: decimal.NewFromInt(100), Ending: decimal.NewFromInt(80)},
+	KRW: {Step: decimal.NewFromInt(100), Ending: decimal.NewFromInt(0)},
+	VND: {Step: decimal.NewFromInt(1000), Ending: decimal.NewFromInt(900)},
+}
+
+// DefaultPriceEnding returns default price ending of given currency, with given direction.
+//
+// Currencies missing in DefaultPriceEndings end with .99 (or .999 for 3 decimals ones),
+// currencies without fraction end with whole number minus one (19, 29, ...).
+func DefaultPriceEnding(currency string, direction PriceEndingDirection) (PriceEnding, error) {
+	unit, err := validateCurrency(currency)
+	if err != nil {
+		return PriceEnding{}, err
+	}
+	if ending, ok := DefaultPriceEndings[unit]; ok {
+		ending.Direction = direction
+		return ending, nil
+	}
+
+	precision, err := GetCurrencyPrecision(unit)
+	if err != nil {
+		return PriceEnding{}, err
+	}
+	if precision == 0 {
+		return PriceEnding{
+			Step:      decimal.NewFromInt(10),
+			Ending:    decimal.NewFromInt(9),
+			Direction: direction,
+		}, nil
+	}
+	return PriceEnding{
+		Step:      decimal.NewFromInt(1),
+		Ending:    decimal.NewFromInt(1).Sub(decimal.New(1, -int32(precision))),
+		Direction: direction,
+	}, nil
+}
+
+func (p PriceEnding) validate() error {
+	if !p.Step.IsPositive() || p.Ending.IsNegative() || p.Ending.GreaterThanOrEqual(p.Step) {
+		return ErrInvalidPriceEnding
+	}
+	switch p.Direction {
+	case EndingNearest, EndingUp, EndingDown:
+		return nil
+	default:
+		return ErrInvalidPriceEnding
+	}
+}
+
+// apply snaps given amount to current price ending.
+// Amounts below the first ending always move up, since prices can not be negative.
+func (p PriceEnding) apply(amount decimal.Decimal) decimal.Decimal {
+	quo, rem := amount.Sub(p.Ending).QuoRem(p.Step, 0)
+	if rem.IsNegative() {
+		quo = quo.Sub(decimal.NewFromInt(1))
+	}
+
+	down := quo.Mul(p.Step).Add(p.Ending)
+	if down.Equal(amount) {
+		return amount
+	}
+	up := down.Add(p.Step)
+	if down.IsNegative() {
+		return up
+	}
+
+	switch p.Direction {
+	case EndingUp:
+		return up
+	case EndingDown:
+		return down
+	default:
+		if amount.Sub(down).LessThan(up.Sub(amount)) {
+			return down
+		}
+		return up
+	}
+}
+
+// ApplyPriceEnding snaps given price to given ending.
+// Both ends of ranges and both net and gross of taxed prices are snapped.
+func ApplyPriceEnding[K MoneyObject, T MoneyInterface[K]](price T, ending PriceEnding) (*K, error) {
+	if err := ending.validate(); err != nil {
+		return nil, err
+	}
+	return ptr(price.applyPriceEnding(ending))
+}
+
+// ApplyDefaultPriceEnding snaps given price to default ending of its currency.
+func ApplyDefaultPriceEnding[K MoneyObject, T MoneyInterface[K]](price T, direction PriceEndingDirection) (*K, error) {
+	ending, err := DefaultPriceEnding(price.GetCurrency(), direction)
+	if err != nil {
+		return nil, err
+	}
+	return ApplyPriceEnding[K](price, ending)
+}
+
+func (m Money) applyPriceEnding(ending PriceEnding) (Money, error) {
+	return Money{
+		amount:   ending.apply(m.amount),
+		currency: m.currency,
+	}, nil
+}
+
+func (t TaxedMoney) applyPriceEnding(ending PriceEnding) (TaxedMoney, error) {
+	net, _ := t.net.applyPriceEnding(ending)
+	gross, _ := t.gross.applyPriceEnding(ending)
+	return newTaxedMoney(net, gross)
+}
+
+func (m MoneyRange) applyPriceEnding(ending PriceEnding) (MoneyRange, error) {
+	start, _ := m.start.applyPriceEnding(ending)
+	stop, _ := m.stop.applyPriceEnding(ending)
+	return newMoneyRange(start, stop, m.startBound, m.stopBound)
+}
+
+func (t TaxedMoneyRange) applyPriceEnding(ending PriceEnding) (TaxedMoneyRange, error) {
+	start, err := t.start.applyPriceEnding(ending)
+	if err != nil {
+		return TaxedMoneyRange{}, err
+	}
+	stop, err := t.stop.applyPriceEnding(ending)
+	if err != nil {
+		return TaxedMoneyRange{}, err
+	}
+	return newTaxedMoneyRange(start, stop, t.startBound, t.stopBound)
+}
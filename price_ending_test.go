@@ -0,0 +1,69 @@
+package goprices
+
+import (
+	"testing"
+
+	"github.com/site-name/decimal"
+)
+
+func TestApplyPriceEnding(t *testing.T) {
+	type testUnit struct {
+		amount    float64
+		currency  string
+		direction PriceEndingDirection
+		expected  string
+	}
+	testCases := []testUnit{
+		{10.2, USD, EndingNearest, "9.99"},
+		{10.5, USD, EndingNearest, "10.99"},
+		{10.2, USD, EndingDown, "9.99"},
+		{10.2, USD, EndingUp, "10.99"},
+		{9.99, USD, EndingUp, "9.99"},
+		{0.5, USD, EndingDown, "0.99"},
+		{2013, JPY, EndingNearest, "1980"},
+		{2013, JPY, EndingUp, "2080"},
+		{23, ISK, EndingNearest, "19"},
+		{23, HUF, EndingNearest, "22.99"}, // HUF has 2 decimal places since ISO 4217 tables are generated
+		{10.1, KWD, EndingDown, "9.999"},
+	}
+
+	for index, test := range testCases {
+		m, err := NewMoney(test.amount, test.currency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := ApplyDefaultPriceEnding(m, test.direction)
+		if err != nil {
+			t.Fatalf("Error at index: %d, err: %v", index, err)
+		}
+		if res.amount.String() != test.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, test.expected, res.amount)
+		}
+	}
+}
+
+func TestApplyPriceEndingRange(t *testing.T) {
+	mRange, err := NewMoneyRangeFromFloats(10.2, 24.5, USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ending := PriceEnding{
+		Step:      decimal.NewFromInt(5),
+		Ending:    decimal.NewFromFloat(4.95),
+		Direction: EndingUp,
+	}
+	res, err := ApplyPriceEnding(mRange, ending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.start.amount.String() != "14.95" || res.stop.amount.String() != "24.95" {
+		t.Fatalf("unexpected range: %s", res)
+	}
+
+	ending.Ending = decimal.NewFromInt(5)
+	_, err = ApplyPriceEnding(mRange, ending)
+	if err != ErrInvalidPriceEnding {
+		t.Fatalf("expected ErrInvalidPriceEnding, got: %v", err)
+	}
+}
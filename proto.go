@@ -0,0 +1,239 @@
+package goprices
+
+import "github.com/site-name/decimal"
+
+const (
+	nanosPerUnit = 1_000_000_000
+	nanosExp     = 9
+)
+
+// ProtoMoneyMessage is implemented by google.type.Money generated code (*money.Money),
+// so it can be converted without this package depending on it.
+type ProtoMoneyMessage interface {
+	GetCurrencyCode() string
+	GetUnits() int64
+	GetNanos() int32
+}
+
+// ProtoMoney mirrors google.type.Money: an amount is Units + Nanos * 10^-9.
+// Nanos must be in [-999,999,999, +999,999,999] and have the same sign as Units, when Units is not zero.
+type ProtoMoney struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+var _ ProtoMoneyMessage = ProtoMoney{}
+
+func (p ProtoMoney) GetCurrencyCode() string {
+	return p.CurrencyCode
+}
+
+func (p ProtoMoney) GetUnits() int64 {
+	return p.Units
+}
+
+func (p ProtoMoney) GetNanos() int32 {
+	return p.Nanos
+}
+
+// NewProtoMoney converts given money to units and nanos.
+//
+// Returned error could be ErrPrecisionLoss if money has more than 9 decimal places,
+// or ErrOverflow if units do not fit in an int64.
+//
+// E.g:
+//
+//	NewProtoMoney(-1.75 USD) => ProtoMoney{"USD", -1, -750000000}
+func NewProtoMoney(m Money) (ProtoMoney, error) {
+	if !m.amount.Shift(nanosExp).IsInteger() {
+		return ProtoMoney{}, ErrPrecisionLoss
+	}
+	units := m.amount.Truncate(0).BigInt()
+	if !units.IsInt64() {
+		return ProtoMoney{}, ErrOverflow
+	}
+
+	return ProtoMoney{
+		CurrencyCode: m.currency,
+		Units:        units.Int64(),
+		Nanos:        int32(m.amount.Sub(decimal.NewFromBigInt(units, 0)).Shift(nanosExp).IntPart()),
+	}, nil
+}
+
+// NewMoneyFromProto converts given google.type.Money, or a ProtoMoney, to Money.
+// Negative amounts are accepted.
+//
+// Returned error could be `nil`, `ErrInvalidProtoMoney` if nanos are out of range or their sign
+// differs from units', or an *UnknownCurrencyError
+func NewMoneyFromProto(p ProtoMoneyMessage) (*Money, error) {
+	units, nanos := p.GetUnits(), p.GetNanos()
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit ||
+		(units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return nil, ErrInvalidProtoMoney
+	}
+	amount := decimal.New(units, 0).Add(decimal.New(int64(nanos), -nanosExp))
+	return NewSignedMoneyFromDecimal(amount, p.GetCurrencyCode())
+}
+
+// ProtoTaxedMoney mirrors goprices.v1.TaxedMoney message
+type ProtoTaxedMoney struct {
+	Net   ProtoMoney
+	Gross ProtoMoney
+}
+
+// NewProtoTaxedMoney converts given taxed money to its proto representation. See NewProtoMoney
+func NewProtoTaxedMoney(t TaxedMoney) (ProtoTaxedMoney, error) {
+	net, err := NewProtoMoney(t.net)
+	if err != nil {
+		return ProtoTaxedMoney{}, err
+	}
+	gross, err := NewProtoMoney(t.gross)
+	if err != nil {
+		return ProtoTaxedMoney{}, err
+	}
+	return ProtoTaxedMoney{net, gross}, nil
+}
+
+// NewTaxedMoneyFromProto converts given proto representation to TaxedMoney. See NewMoneyFromProto
+func NewTaxedMoneyFromProto(p ProtoTaxedMoney) (*TaxedMoney, error) {
+	return ptr(taxedMoneyFromProto(p))
+}
+
+func taxedMoneyFromProto(p ProtoTaxedMoney) (TaxedMoney, error) {
+	net, err := NewMoneyFromProto(p.Net)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	gross, err := NewMoneyFromProto(p.Gross)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	return newTaxedMoney(*net, *gross)
+}
+
+// ProtoMoneyRange mirrors goprices.v1.MoneyRange message, a nil end is unbounded.
+type ProtoMoneyRange struct {
+	Start        *ProtoMoney
+	Stop         *ProtoMoney
+	StartBound   Bound
+	StopBound    Bound
+	CurrencyCode string
+}
+
+// NewProtoMoneyRange converts given money range to its proto representation. See NewProtoMoney
+func NewProtoMoneyRange(m MoneyRange) (ProtoMoneyRange, error) {
+	res := ProtoMoneyRange{
+		StartBound:   m.startBound,
+		StopBound:    m.stopBound,
+		CurrencyCode: m.GetCurrency(),
+	}
+	if m.startBound != Unbounded {
+		start, err := NewProtoMoney(m.start)
+		if err != nil {
+			return ProtoMoneyRange{}, err
+		}
+		res.Start = &start
+	}
+	if m.stopBound != Unbounded {
+		stop, err := NewProtoMoney(m.stop)
+		if err != nil {
+			return ProtoMoneyRange{}, err
+		}
+		res.Stop = &stop
+	}
+	return res, nil
+}
+
+// NewMoneyRangeFromProto converts given proto representation to MoneyRange. Negative ends are accepted.
+//
+// Returned error could be `nil`, `ErrInvalidProtoMoney`, `ErrInvalidBound`, an *UnknownCurrencyError,
+// a *CurrencyMismatchError or an *InvalidRangeError
+func NewMoneyRangeFromProto(p ProtoMoneyRange) (*MoneyRange, error) {
+	start, stop := Money{currency: p.CurrencyCode}, Money{currency: p.CurrencyCode}
+	startBound, stopBound := p.StartBound, p.StopBound
+	if p.Start == nil {
+		startBound = Unbounded
+	} else {
+		value, err := NewMoneyFromProto(p.Start)
+		if err != nil {
+			return nil, err
+		}
+		start = *value
+	}
+	if p.Stop == nil {
+		stopBound = Unbounded
+	} else {
+		value, err := NewMoneyFromProto(p.Stop)
+		if err != nil {
+			return nil, err
+		}
+		stop = *value
+	}
+	res, err := newMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeclaredCurrency(p.CurrencyCode, res.GetCurrency()); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ProtoTaxedMoneyRange mirrors goprices.v1.TaxedMoneyRange message, a nil end is unbounded.
+type ProtoTaxedMoneyRange struct {
+	Start        *ProtoTaxedMoney
+	Stop         *ProtoTaxedMoney
+	StartBound   Bound
+	StopBound    Bound
+	CurrencyCode string
+}
+
+// NewProtoTaxedMoneyRange converts given taxed money range to its proto representation. See NewProtoMoney
+func NewProtoTaxedMoneyRange(t TaxedMoneyRange) (ProtoTaxedMoneyRange, error) {
+	res := ProtoTaxedMoneyRange{
+		StartBound:   t.startBound,
+		StopBound:    t.stopBound,
+		CurrencyCode: t.GetCurrency(),
+	}
+	if t.startBound != Unbounded {
+		start, err := NewProtoTaxedMoney(t.start)
+		if err != nil {
+			return ProtoTaxedMoneyRange{}, err
+		}
+		res.Start = &start
+	}
+	if t.stopBound != Unbounded {
+		stop, err := NewProtoTaxedMoney(t.stop)
+		if err != nil {
+			return ProtoTaxedMoneyRange{}, err
+		}
+		res.Stop = &stop
+	}
+	return res, nil
+}
+
+// NewTaxedMoneyRangeFromProto converts given proto representation to TaxedMoneyRange. See NewMoneyRangeFromProto
+func NewTaxedMoneyRangeFromProto(p ProtoTaxedMoneyRange) (*TaxedMoneyRange, error) {
+	start, stop := zeroOf[TaxedMoney](p.CurrencyCode), zeroOf[TaxedMoney](p.CurrencyCode)
+	startBound, stopBound := p.StartBound, p.StopBound
+	var err error
+	if p.Start == nil {
+		startBound = Unbounded
+	} else if start, err = taxedMoneyFromProto(*p.Start); err != nil {
+		return nil, err
+	}
+	if p.Stop == nil {
+		stopBound = Unbounded
+	} else if stop, err = taxedMoneyFromProto(*p.Stop); err != nil {
+		return nil, err
+	}
+	res, err := newTaxedMoneyRange(start, stop, startBound, stopBound)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeclaredCurrency(p.CurrencyCode, res.GetCurrency()); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,99 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProtoMoney(t *testing.T) {
+	type testUnit struct {
+		amount float64
+		units  int64
+		nanos  int32
+	}
+	for index, unit := range []testUnit{
+		{0, 0, 0},
+		{1.75, 1, 750000000},
+		{-1.75, -1, -750000000},
+		{-0.5, 0, -500000000},
+		{12, 12, 0},
+		{0.000000001, 0, 1},
+	} {
+		money := *must(NewSignedMoney(unit.amount, USD))
+		proto, err := NewProtoMoney(money)
+		if err != nil {
+			t.Fatalf("Error at index: %d, %v", index, err)
+		}
+		if proto.CurrencyCode != USD || proto.Units != unit.units || proto.Nanos != unit.nanos {
+			t.Fatalf("Error at index: %d, expected: {%d, %d}, got: %+v", index, unit.units, unit.nanos, proto)
+		}
+
+		decoded, err := NewMoneyFromProto(proto)
+		if err != nil {
+			t.Fatalf("Error at index: %d, %v", index, err)
+		}
+		if !decoded.Equal(money) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, money, decoded)
+		}
+	}
+
+	if _, err := NewProtoMoney(*must(NewMoney(0.0000000001, USD))); !errors.Is(err, ErrPrecisionLoss) {
+		t.Fatalf("expected ErrPrecisionLoss, got: %v", err)
+	}
+	if _, err := NewProtoMoney(*must(NewMoney(1e19, USD))); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected ErrOverflow, got: %v", err)
+	}
+}
+
+func TestNewMoneyFromProtoValidation(t *testing.T) {
+	for index, proto := range []ProtoMoney{
+		{USD, 1, -1},
+		{USD, -1, 1},
+		{USD, 0, 1000000000},
+		{USD, 0, -1000000000},
+	} {
+		if _, err := NewMoneyFromProto(proto); !errors.Is(err, ErrInvalidProtoMoney) {
+			t.Fatalf("Error at index: %d, expected ErrInvalidProtoMoney, got: %v", index, err)
+		}
+	}
+	if _, err := NewMoneyFromProto(ProtoMoney{"abc", 1, 0}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestProtoRanges(t *testing.T) {
+	taxed := *must(NewTaxedMoneyFromFloats(10, 12.3, EUR))
+	protoTaxed, err := NewProtoTaxedMoney(taxed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded := must(NewTaxedMoneyFromProto(protoTaxed)); !decoded.Equal(taxed) {
+		t.Fatalf("expected: %s, got: %s", taxed, decoded)
+	}
+
+	moneyRange := *must(NewMoneyRangeFrom(*must(NewMoney(20, USD)), Exclusive))
+	protoRange, err := NewProtoMoneyRange(moneyRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if protoRange.Stop != nil || protoRange.StopBound != Unbounded || protoRange.CurrencyCode != USD {
+		t.Fatalf("unexpected proto range: %+v", protoRange)
+	}
+	if decoded := must(NewMoneyRangeFromProto(protoRange)); !decoded.Equal(moneyRange) {
+		t.Fatalf("expected: %s, got: %s", moneyRange, decoded)
+	}
+
+	protoRange.CurrencyCode = EUR
+	if _, err := NewMoneyRangeFromProto(protoRange); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+
+	taxedRange := *must(NewTaxedMoneyRangeTo(taxed, Inclusive))
+	protoTaxedRange, err := NewProtoTaxedMoneyRange(taxedRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded := must(NewTaxedMoneyRangeFromProto(protoTaxedRange)); !decoded.Equal(taxedRange) {
+		t.Fatalf("expected: %s, got: %s", taxedRange, decoded)
+	}
+}
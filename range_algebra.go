@@ -0,0 +1,251 @@
+package goprices
+
+import (
+	"strings"
+
+	"github.com/site-name/decimal"
+)
+
+var half = decimal.New(5, -1)
+
+// Intersect returns the range of values contained in both current money range and given other, respecting bounds.
+// Closed ranges sharing a single end intersect in a degenerate range.
+//
+// Returned error could be `nil`, `ErrRangesDisjoint` or a *CurrencyMismatchError
+func (m MoneyRange) Intersect(other MoneyRange) (*MoneyRange, error) {
+	start, stop, err := intersect(m.currencies(other), m.startEnd(), m.stopEnd(), other.startEnd(), other.stopEnd(), Money.Compare)
+	if err != nil {
+		return nil, err
+	}
+	return &MoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Union returns the range of values contained in current money range or given other.
+// Unlike Span, the ranges must overlap or be adjacent like [1, 2) and [2, 3], since the union of disjoint ranges is not a range.
+//
+// Returned error could be `nil`, `ErrRangesDisjoint` or a *CurrencyMismatchError
+func (m MoneyRange) Union(other MoneyRange) (*MoneyRange, error) {
+	start, stop, err := union(m.currencies(other), m.startEnd(), m.stopEnd(), other.startEnd(), other.stopEnd(), Money.Compare)
+	if err != nil {
+		return nil, err
+	}
+	return &MoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Span returns the smallest range containing both current money range and given other.
+//
+// Returned error could be `nil` or a *CurrencyMismatchError
+func (m MoneyRange) Span(other MoneyRange) (*MoneyRange, error) {
+	start, stop, err := span(m.currencies(other), m.startEnd(), m.stopEnd(), other.startEnd(), other.stopEnd(), Money.Compare)
+	if err != nil {
+		return nil, err
+	}
+	return &MoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Overlaps checks if current money range and given other have at least one value in common.
+// Ranges of different currencies never overlap.
+func (m MoneyRange) Overlaps(other MoneyRange) bool {
+	_, _, err := intersect(m.currencies(other), m.startEnd(), m.stopEnd(), other.startEnd(), other.stopEnd(), Money.Compare)
+	return err == nil
+}
+
+// ContainsRange checks if all values of given other are in current money range.
+//
+// start <= other.start && other.stop <= stop
+func (m MoneyRange) ContainsRange(other MoneyRange) bool {
+	return containsRange(m.currencies(other), m.startEnd(), m.stopEnd(), other.startEnd(), other.stopEnd(), Money.Compare)
+}
+
+// Width returns stop - start, bounds are not taken into account.
+// If returned error is not nil, it is ErrRangeUnbounded
+func (m MoneyRange) Width() (*Money, error) {
+	if !m.IsBounded() {
+		return nil, ErrRangeUnbounded
+	}
+	res := width(m.start, m.stop)
+	return &res, nil
+}
+
+// Midpoint returns the value halfway between start and stop, it is not rounded.
+// If returned error is not nil, it is ErrRangeUnbounded
+func (m MoneyRange) Midpoint() (*Money, error) {
+	if !m.IsBounded() {
+		return nil, ErrRangeUnbounded
+	}
+	res := midpoint(m.start, m.stop)
+	return &res, nil
+}
+
+func (m MoneyRange) currencies(other MoneyRange) [2]string {
+	return [2]string{m.GetCurrency(), other.GetCurrency()}
+}
+
+// Intersect returns the range of values contained in both current taxed money range and given other,
+// comparing ends by gross if fromGross is true, net otherwise. See MoneyRange.Intersect
+func (t TaxedMoneyRange) Intersect(other TaxedMoneyRange, fromGross bool) (*TaxedMoneyRange, error) {
+	start, stop, err := intersect(t.currencies(other), t.startEnd(), t.stopEnd(), other.startEnd(), other.stopEnd(), taxedCompare(fromGross))
+	if err != nil {
+		return nil, err
+	}
+	return &TaxedMoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Union returns the range of values contained in current taxed money range or given other,
+// comparing ends by gross if fromGross is true, net otherwise. See MoneyRange.Union
+func (t TaxedMoneyRange) Union(other TaxedMoneyRange, fromGross bool) (*TaxedMoneyRange, error) {
+	start, stop, err := union(t.currencies(other), t.startEnd(), t.stopEnd(), other.startEnd(), other.stopEnd(), taxedCompare(fromGross))
+	if err != nil {
+		return nil, err
+	}
+	return &TaxedMoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Span returns the smallest range containing both current taxed money range and given other,
+// comparing ends by gross if fromGross is true, net otherwise. See MoneyRange.Span
+func (t TaxedMoneyRange) Span(other TaxedMoneyRange, fromGross bool) (*TaxedMoneyRange, error) {
+	start, stop, err := span(t.currencies(other), t.startEnd(), t.stopEnd(), other.startEnd(), other.stopEnd(), taxedCompare(fromGross))
+	if err != nil {
+		return nil, err
+	}
+	return &TaxedMoneyRange{start.value, stop.value, start.bound, stop.bound}, nil
+}
+
+// Overlaps checks if current taxed money range and given other have at least one value in common,
+// comparing ends by gross if fromGross is true, net otherwise.
+func (t TaxedMoneyRange) Overlaps(other TaxedMoneyRange, fromGross bool) bool {
+	_, _, err := intersect(t.currencies(other), t.startEnd(), t.stopEnd(), other.startEnd(), other.stopEnd(), taxedCompare(fromGross))
+	return err == nil
+}
+
+// ContainsRange checks if all values of given other are in current taxed money range,
+// comparing ends by gross if fromGross is true, net otherwise.
+func (t TaxedMoneyRange) ContainsRange(other TaxedMoneyRange, fromGross bool) bool {
+	return containsRange(t.currencies(other), t.startEnd(), t.stopEnd(), other.startEnd(), other.stopEnd(), taxedCompare(fromGross))
+}
+
+// Width returns stop - start, for both net and gross. Bounds are not taken into account.
+// If returned error is not nil, it is ErrRangeUnbounded
+func (t TaxedMoneyRange) Width() (*TaxedMoney, error) {
+	if !t.IsBounded() {
+		return nil, ErrRangeUnbounded
+	}
+	return &TaxedMoney{
+		net:   width(t.start.net, t.stop.net),
+		gross: width(t.start.gross, t.stop.gross),
+	}, nil
+}
+
+// Midpoint returns the value halfway between start and stop, for both net and gross. It is not rounded.
+// If returned error is not nil, it is ErrRangeUnbounded
+func (t TaxedMoneyRange) Midpoint() (*TaxedMoney, error) {
+	if !t.IsBounded() {
+		return nil, ErrRangeUnbounded
+	}
+	return &TaxedMoney{
+		net:   midpoint(t.start.net, t.stop.net),
+		gross: midpoint(t.start.gross, t.stop.gross),
+	}, nil
+}
+
+func (t TaxedMoneyRange) currencies(other TaxedMoneyRange) [2]string {
+	return [2]string{t.GetCurrency(), other.GetCurrency()}
+}
+
+func width(start, stop Money) Money {
+	return Money{stop.amount.Sub(start.amount), start.currency}
+}
+
+func midpoint(start, stop Money) Money {
+	return Money{start.amount.Add(stop.amount).Mul(half), start.currency}
+}
+
+// checkCurrencies returns a *CurrencyMismatchError if currencies of two ranges differ.
+// It is needed since unbounded ends are never compared.
+func checkCurrencies(currencies [2]string) error {
+	if !strings.EqualFold(currencies[0], currencies[1]) {
+		return &CurrencyMismatchError{Left: currencies[0], Right: currencies[1]}
+	}
+	return nil
+}
+
+// later returns the one of given ends coming last according to given order
+func later[T any](a, b rangeEnd[T], order func(a, b rangeEnd[T]) (int, error)) (rangeEnd[T], error) {
+	c, err := order(a, b)
+	if err != nil || c >= 0 {
+		return a, err
+	}
+	return b, nil
+}
+
+// earlier returns the one of given ends coming first according to given order
+func earlier[T any](a, b rangeEnd[T], order func(a, b rangeEnd[T]) (int, error)) (rangeEnd[T], error) {
+	c, err := order(a, b)
+	if err != nil || c <= 0 {
+		return a, err
+	}
+	return b, nil
+}
+
+// intersect returns ends of the intersection of ranges a and b
+func intersect[T any](currencies [2]string, aStart, aStop, bStart, bStop rangeEnd[T], compare func(a, b T) (int, error)) (start, stop rangeEnd[T], err error) {
+	if err = checkCurrencies(currencies); err != nil {
+		return
+	}
+	startOrder := func(a, b rangeEnd[T]) (int, error) { return compareStarts(a, b, compare) }
+	stopOrder := func(a, b rangeEnd[T]) (int, error) { return compareStops(a, b, compare) }
+
+	if start, err = later(aStart, bStart, startOrder); err != nil {
+		return
+	}
+	if stop, err = earlier(aStop, bStop, stopOrder); err != nil {
+		return
+	}
+	empty, err := isEmpty(start, stop, compare)
+	if err == nil && empty {
+		err = ErrRangesDisjoint
+	}
+	return
+}
+
+// span returns ends of the smallest range containing both ranges a and b
+func span[T any](currencies [2]string, aStart, aStop, bStart, bStop rangeEnd[T], compare func(a, b T) (int, error)) (start, stop rangeEnd[T], err error) {
+	if err = checkCurrencies(currencies); err != nil {
+		return
+	}
+	startOrder := func(a, b rangeEnd[T]) (int, error) { return compareStarts(a, b, compare) }
+	stopOrder := func(a, b rangeEnd[T]) (int, error) { return compareStops(a, b, compare) }
+
+	if start, err = earlier(aStart, bStart, startOrder); err != nil {
+		return
+	}
+	stop, err = later(aStop, bStop, stopOrder)
+	return
+}
+
+// union returns ends of the union of ranges a and b, which must overlap or be adjacent
+func union[T any](currencies [2]string, aStart, aStop, bStart, bStop rangeEnd[T], compare func(a, b T) (int, error)) (start, stop rangeEnd[T], err error) {
+	if err = checkCurrencies(currencies); err != nil {
+		return
+	}
+	for _, ends := range [][2]rangeEnd[T]{{aStop, bStart}, {bStop, aStart}} {
+		gap, err := hasGap(ends[0], ends[1], compare)
+		if err != nil {
+			return start, stop, err
+		}
+		if gap {
+			return start, stop, ErrRangesDisjoint
+		}
+	}
+	return span(currencies, aStart, aStop, bStart, bStop, compare)
+}
+
+// containsRange checks if range a contains range b
+func containsRange[T any](currencies [2]string, aStart, aStop, bStart, bStop rangeEnd[T], compare func(a, b T) (int, error)) bool {
+	if checkCurrencies(currencies) != nil {
+		return false
+	}
+	c1, err1 := compareStarts(aStart, bStart, compare)
+	c2, err2 := compareStops(bStop, aStop, compare)
+	return err1 == nil && err2 == nil && c1 <= 0 && c2 <= 0
+}
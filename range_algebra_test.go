@@ -0,0 +1,116 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMoneyRangeIntersectUnion(t *testing.T) {
+	newRange := func(start, stop float64) MoneyRange {
+		return *must(NewMoneyRangeFromFloats(start, stop, USD))
+	}
+
+	type testUnit struct {
+		a, b      MoneyRange
+		intersect *MoneyRange // nil means disjoint
+		span      MoneyRange
+	}
+	for index, unit := range []testUnit{
+		{newRange(1, 5), newRange(3, 8), ptrTo(newRange(3, 5)), newRange(1, 8)},
+		{newRange(3, 8), newRange(1, 5), ptrTo(newRange(3, 5)), newRange(1, 8)},
+		{newRange(1, 10), newRange(3, 5), ptrTo(newRange(3, 5)), newRange(1, 10)},
+		{newRange(1, 3), newRange(3, 5), ptrTo(newRange(3, 3)), newRange(1, 5)},
+		{newRange(2, 2), newRange(1, 5), ptrTo(newRange(2, 2)), newRange(1, 5)},
+		{newRange(1, 2), newRange(3, 5), nil, newRange(1, 5)},
+	} {
+		intersection, err := unit.a.Intersect(unit.b)
+		if unit.intersect == nil {
+			if !errors.Is(err, ErrRangesDisjoint) {
+				t.Fatalf("Error at index: %d, expected ErrRangesDisjoint, got: %v", index, err)
+			}
+			if unit.a.Overlaps(unit.b) {
+				t.Fatalf("Error at index: %d, expected no overlap", index)
+			}
+			if _, err := unit.a.Union(unit.b); !errors.Is(err, ErrRangesDisjoint) {
+				t.Fatalf("Error at index: %d, expected ErrRangesDisjoint, got: %v", index, err)
+			}
+		} else {
+			if err != nil || !intersection.Equal(*unit.intersect) {
+				t.Fatalf("Error at index: %d, expected: %s, got: %v, %v", index, unit.intersect, intersection, err)
+			}
+			if !unit.a.Overlaps(unit.b) {
+				t.Fatalf("Error at index: %d, expected overlap", index)
+			}
+			if union := must(unit.a.Union(unit.b)); !union.Equal(unit.span) {
+				t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.span, union)
+			}
+		}
+
+		if span := must(unit.a.Span(unit.b)); !span.Equal(unit.span) {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.span, span)
+		}
+	}
+
+	eur := *must(NewMoneyRangeFromFloats(1, 5, EUR))
+	if _, err := newRange(1, 5).Intersect(eur); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+	if newRange(1, 5).Overlaps(eur) {
+		t.Fatal("ranges of different currencies must not overlap")
+	}
+}
+
+func TestMoneyRangeMeasures(t *testing.T) {
+	moneyRange := *must(NewMoneyRangeFromFloats(1.25, 4, USD))
+
+	if !moneyRange.ContainsRange(*must(NewMoneyRangeFromFloats(2, 4, USD))) {
+		t.Fatal("expected range to be contained")
+	}
+	if moneyRange.ContainsRange(*must(NewMoneyRangeFromFloats(1, 2, USD))) {
+		t.Fatal("expected range not to be contained")
+	}
+	if width := must(moneyRange.Width()); !width.Equal(*must(NewMoney(2.75, USD))) {
+		t.Fatalf("expected 2.75 USD, got: %s", width)
+	}
+	if mid := must(moneyRange.Midpoint()); !mid.Equal(*must(NewMoney(2.625, USD))) {
+		t.Fatalf("expected 2.625 USD, got: %s", mid)
+	}
+}
+
+func TestTaxedMoneyRangeIntersect(t *testing.T) {
+	newRange := func(startNet, startGross, stopNet, stopGross float64) TaxedMoneyRange {
+		return *must(NewTaxedMoneyRange(
+			*must(NewTaxedMoneyFromFloats(startNet, startGross, USD)),
+			*must(NewTaxedMoneyFromFloats(stopNet, stopGross, USD)),
+		))
+	}
+	a := newRange(10, 12, 20, 24)
+	b := newRange(11, 11.5, 30, 36)
+
+	// by net, b starts later; by gross, a starts later
+	byNet := must(a.Intersect(b, false))
+	if !byNet.Equal(newRange(11, 11.5, 20, 24)) {
+		t.Fatalf("unexpected intersection: %s", byNet)
+	}
+	byGross := must(a.Intersect(b, true))
+	if !byGross.Equal(newRange(10, 12, 20, 24)) {
+		t.Fatalf("unexpected intersection: %s", byGross)
+	}
+
+	if !a.ContainsRange(newRange(12, 13, 15, 17), true) || !a.Overlaps(b, true) {
+		t.Fatal("unexpected range predicates result")
+	}
+	if span := must(a.Span(b, true)); !span.Equal(newRange(11, 11.5, 30, 36)) {
+		t.Fatalf("unexpected span: %s", span)
+	}
+	if width := must(a.Width()); !width.Equal(*must(NewTaxedMoneyFromFloats(10, 12, USD))) {
+		t.Fatalf("unexpected width: %s", width)
+	}
+	if mid := must(a.Midpoint()); !mid.Equal(*must(NewTaxedMoneyFromFloats(15, 18, USD))) {
+		t.Fatalf("unexpected midpoint: %s", mid)
+	}
+}
+
+func ptrTo[T any](value T) *T {
+	return &value
+}
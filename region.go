@@ -0,0 +1,144 @@
+package goprices
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// regionCurrency is a currency used as legal tender in a region during [from, to).
+// Zero from or to means unbounded.
+type regionCurrency struct {
+	code     string
+	from, to time.Time
+}
+
+func (r regionCurrency) activeAt(at time.Time) bool {
+	return (r.from.IsZero() || !at.Before(r.from)) && (r.to.IsZero() || at.Before(r.to))
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// regionCurrencyChanges contains changes newer than the CLDR data shipped with golang.org/x/text.
+// They take precedence over x/text data for the same currency.
+var regionCurrencyChanges = map[string][]regionCurrency{
+	"HR": {
+		{code: EUR, from: date(2023, time.January, 1)},
+		{code: HRK, from: date(1994, time.May, 30), to: date(2023, time.January, 1)},
+	},
+	"MR": {
+		{code: MRU, from: date(2018, time.January, 1)},
+		{code: MRO, from: date(1973, time.June, 29), to: date(2018, time.January, 1)},
+	},
+	"SL": {
+		{code: SLE, from: date(2022, time.July, 1)},
+	},
+	"VE": {
+		{code: VES, from: date(2018, time.August, 20)},
+		{code: VED, from: date(2021, time.October, 1)},
+		{code: VEF, from: date(2008, time.January, 1), to: date(2018, time.August, 20)},
+	},
+	"ZW": {
+		{code: ZWG, from: date(2024, time.June, 25)},
+		{code: ZWL, from: date(2009, time.February, 2), to: date(2009, time.April, 12)},
+		{code: ZWL, from: date(2019, time.February, 22), to: date(2024, time.June, 25)},
+	},
+}
+
+// regionCurrencies returns all currencies ever used as legal tender in given region, most relevant first.
+func regionCurrencies(region string) ([]regionCurrency, error) {
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return nil, ErrUnknownRegion
+	}
+
+	changes := regionCurrencyChanges[r.String()]
+	overridden := map[string]bool{}
+	for _, change := range changes {
+		overridden[change.code] = true
+	}
+
+	result := append([]regionCurrency{}, changes...)
+	iter := currency.Query(currency.Region(r), currency.Historical)
+	for iter.Next() {
+		code := iter.Unit().String()
+		if !iter.IsTender() || overridden[code] {
+			continue
+		}
+		item := regionCurrency{code: code}
+		item.from, _ = iter.From()
+		if to, ok := iter.To(); ok {
+			item.to = to
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// CurrencyByNumeric finds currency with given ISO 4217 numeric code, e.g: "840" or "8" for "008".
+//
+// Returned error could be `nil` or an *UnknownCurrencyError
+func CurrencyByNumeric(code string) (Currency, error) {
+	if _, err := strconv.Atoi(code); err != nil || len(code) > 3 {
+		return Currency{}, &UnknownCurrencyError{Code: code}
+	}
+	for len(code) < 3 {
+		code = "0" + code
+	}
+
+	c, ok := DefaultRegistry.LookupNumeric(code)
+	if !ok {
+		return Currency{}, &UnknownCurrencyError{Code: code}
+	}
+	return c, nil
+}
+
+// CurrenciesForCountry returns currencies currently used as legal tender in given region, sorted by code.
+// region is an ISO 3166 code, e.g: "US", "pa".
+//
+// Returned error could be `nil` or `ErrUnknownRegion`
+func CurrenciesForCountry(region string) ([]Currency, error) {
+	items, err := regionCurrencies(region)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := []Currency{}
+	for _, item := range items {
+		if !item.activeAt(now) {
+			continue
+		}
+		if c, ok := DefaultRegistry.Lookup(item.code); ok {
+			res = append(res, c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Code < res[j].Code })
+	return res, nil
+}
+
+// CountryCurrency returns the main currency used as legal tender in given region at given time,
+// e.g: CountryCurrency("HR", time.Date(2020, ...)) => HRK.
+//
+// Returned error could be `nil`, `ErrUnknownRegion` or a *NoCurrencyError when no known currency was in use.
+func CountryCurrency(region string, at time.Time) (Currency, error) {
+	items, err := regionCurrencies(region)
+	if err != nil {
+		return Currency{}, err
+	}
+
+	for _, item := range items {
+		if !item.activeAt(at) {
+			continue
+		}
+		if c, ok := DefaultRegistry.Lookup(item.code); ok {
+			return c, nil
+		}
+	}
+	return Currency{}, &NoCurrencyError{Region: region, At: at}
+}
@@ -0,0 +1,91 @@
+package goprices
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCurrencyByNumeric(t *testing.T) {
+	type testUnit struct {
+		numeric  string
+		expected string
+	}
+	testCases := []testUnit{
+		{"840", USD},
+		{"978", EUR},
+		{"8", ALL},
+		{"928", VES},
+		{"937", VEF},
+	}
+	for index, test := range testCases {
+		c, err := CurrencyByNumeric(test.numeric)
+		if err != nil {
+			t.Fatalf("Error at index: %d, err: %v", index, err)
+		}
+		if c.Code != test.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, test.expected, c.Code)
+		}
+	}
+
+	for _, code := range []string{"", "abc", "1234", "001"} {
+		if _, err := CurrencyByNumeric(code); !errors.Is(err, ErrUnknownCurrency) {
+			t.Fatalf("expected ErrUnknownCurrency for %q, got: %v", code, err)
+		}
+	}
+}
+
+func TestCurrenciesForCountry(t *testing.T) {
+	res, err := CurrenciesForCountry("pa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].Code != PAB || res[1].Code != USD {
+		t.Fatalf("unexpected currencies: %v", res)
+	}
+
+	res, err = CurrenciesForCountry("HR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Code != EUR {
+		t.Fatalf("unexpected currencies: %v", res)
+	}
+
+	if _, err := CurrenciesForCountry("not a region"); err != ErrUnknownRegion {
+		t.Fatalf("expected ErrUnknownRegion, got: %v", err)
+	}
+}
+
+func TestCountryCurrency(t *testing.T) {
+	type testUnit struct {
+		region   string
+		at       time.Time
+		expected string
+	}
+	testCases := []testUnit{
+		{"HR", date(2020, time.June, 1), HRK},
+		{"HR", date(2023, time.June, 1), EUR},
+		{"BY", date(2010, time.June, 1), BYR},
+		{"BY", date(2020, time.June, 1), BYN},
+		{"VE", date(2015, time.June, 1), VEF},
+		{"VE", date(2020, time.June, 1), VES},
+		{"US", date(2020, time.June, 1), USD},
+	}
+	for index, test := range testCases {
+		c, err := CountryCurrency(test.region, test.at)
+		if err != nil {
+			t.Fatalf("Error at index: %d, err: %v", index, err)
+		}
+		if c.Code != test.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, test.expected, c.Code)
+		}
+	}
+	for index, region := range []string{"AQ", "HR"} {
+		_, err := CountryCurrency(region, date(1980, time.June, 1))
+		var noCurrency *NoCurrencyError
+		if !errors.As(err, &noCurrency) || noCurrency.Region != region || !errors.Is(err, ErrNoCurrency) {
+			t.Fatalf("Error at index: %d, expected a *NoCurrencyError, got: %v", index, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package goprices
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/currency"
+)
+
+// Registry holds currencies known by this package. It is safe for concurrent use.
+//
+// Codes are case insensitive, they are stored in upper case.
+type Registry struct {
+	mu        sync.RWMutex
+	byCode    map[string]Currency
+	byNumeric map[string]Currency
+}
+
+// DefaultRegistry is used by constructors (NewMoney, NewTaxedMoney, ...) to validate currencies.
+// It is preloaded with ISO 4217 currencies and common cryptocurrencies (BTC, ETH, USDT, USDC, DAI),
+// register your own ones (loyalty points, in-game credits, ...) into it.
+//
+// Precisions come from data/iso4217.csv, which changed a few of the hand written values:
+// HUF, KPW and TZS went from 0 to 2 minor units as listed by ISO, while XAG, XAU and XDR,
+// which ISO lists as "N.A.", went from 0 to 2 as well.
+var DefaultRegistry = newDefaultRegistry()
+
+// NewRegistry returns a new empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		byCode:    map[string]Currency{},
+		byNumeric: map[string]Currency{},
+	}
+}
+
+func newDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	for code, iso := range isoCurrencies {
+		c := Currency{Code: code, Grapheme: code, Template: "1 $", Decimal: ".", Thousand: ","}
+		if format, ok := currencies[code]; ok {
+			c = *format
+		}
+		c.NumericCode = iso.NumericCode
+		c.Fraction = iso.Fraction
+		c.Name = iso.Name
+		c.Fund = iso.Fund
+		c.Withdrawn = iso.Withdrawn
+		if err := registry.Register(c); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, table := range []map[string]*Currency{currencies, cryptoCurrencies} {
+		for code, c := range table {
+			if _, ok := isoCurrencies[code]; ok {
+				continue
+			}
+			c := *c
+			if c.Name == "" {
+				c.Name = CurrenciesMap[code]
+			}
+			if err := registry.Register(c); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return registry
+}
+
+// Register adds given currency to current registry.
+//
+// Returned error could be ErrInvalidCurrency if currency has empty code or negative fraction,
+// or ErrCurrencyRegistered if its code or numeric code is already taken.
+func (r *Registry) Register(c Currency) error {
+	c.Code = strings.ToUpper(strings.TrimSpace(c.Code))
+	if c.Code == "" || c.Fraction < 0 {
+		return ErrInvalidCurrency
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byCode[c.Code]; ok {
+		return ErrCurrencyRegistered
+	}
+	if c.NumericCode != "" {
+		if _, ok := r.byNumeric[c.NumericCode]; ok {
+			return ErrCurrencyRegistered
+		}
+		r.byNumeric[c.NumericCode] = c
+	}
+	r.byCode[c.Code] = c
+	return nil
+}
+
+// Unregister removes currency with given alphabetic code from current registry, e.g: "usd".
+// It returns false if no currency was registered with that code.
+func (r *Registry) Unregister(code string) bool {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.byCode[code]
+	if !ok {
+		return false
+	}
+	delete(r.byCode, code)
+	if c.NumericCode != "" {
+		delete(r.byNumeric, c.NumericCode)
+	}
+	return true
+}
+
+// Lookup finds currency with given alphabetic code, e.g: "usd"
+func (r *Registry) Lookup(code string) (Currency, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.byCode[strings.ToUpper(code)]
+	return c, ok
+}
+
+// LookupNumeric finds currency with given numeric code, e.g: "840"
+func (r *Registry) LookupNumeric(numericCode string) (Currency, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.byNumeric[numericCode]
+	return c, ok
+}
+
+// IsISOCurrency checks if given code is an ISO 4217 currency code, registered or not.
+//
+// Constructors accept only currencies registered in DefaultRegistry, ISO or not (cryptocurrencies, loyalty points, ...).
+func IsISOCurrency(code string) bool {
+	_, err := currency.ParseISO(code)
+	return err == nil
+}
+
+// IsActive checks if given currency is registered in DefaultRegistry and not withdrawn.
+func IsActive(code string) bool {
+	c, ok := DefaultRegistry.Lookup(code)
+	return ok && c.Withdrawn == ""
+}
+
+// All returns all currencies of current registry, sorted by code.
+func (r *Registry) All() []Currency {
+	r.mu.RLock()
+	res := make([]Currency, 0, len(r.byCode))
+	for _, c := range r.byCode {
+		res = append(res, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Code < res[j].Code })
+	return res
+}
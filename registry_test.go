@@ -0,0 +1,98 @@
+package goprices
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	points := Currency{Code: "pts", NumericCode: "9001", Fraction: 0, Grapheme: "pts", Template: "1 $"}
+
+	if err := registry.Register(points); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Register(points); err != ErrCurrencyRegistered {
+		t.Fatalf("expected ErrCurrencyRegistered, got: %v", err)
+	}
+	if err := registry.Register(Currency{Code: " "}); err != ErrInvalidCurrency {
+		t.Fatalf("expected ErrInvalidCurrency, got: %v", err)
+	}
+
+	c, ok := registry.Lookup("PTS")
+	if !ok || c.Code != "PTS" {
+		t.Fatalf("expected to find PTS, got: %v", c)
+	}
+	c, ok = registry.LookupNumeric("9001")
+	if !ok || c.Code != "PTS" {
+		t.Fatalf("expected to find PTS by numeric code, got: %v", c)
+	}
+
+	if !registry.Unregister("pts") || registry.Unregister("PTS") {
+		t.Fatal("expected PTS to be unregistered once")
+	}
+	if _, ok := registry.LookupNumeric("9001"); ok {
+		t.Fatal("expected numeric code of PTS to be unregistered")
+	}
+	if err := registry.Register(points); err != nil {
+		t.Fatalf("expected PTS to be registered again, got: %v", err)
+	}
+}
+
+func TestDefaultRegistryCustomCurrency(t *testing.T) {
+	if _, err := NewMoney(10, "gems"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+
+	err := DefaultRegistry.Register(Currency{Code: "GEMS", Fraction: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		DefaultRegistry.Unregister("GEMS")
+	})
+
+	m, err := NewMoney(10.25, "gems")
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantized, err := m.Quantize(Down, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quantized.amount.String() != "10.2" || quantized.currency != "GEMS" {
+		t.Fatalf("unexpected money: %s", quantized)
+	}
+}
+
+func TestUnregisteredISOCurrency(t *testing.T) {
+	// DEM is a known ISO 4217 code, but it is not in the vendored table
+	if _, err := NewMoney(10, "DEM"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+	if _, err := GetCurrencyPrecision("DEM"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got: %v", err)
+	}
+}
+
+func TestRegistryConcurrentAccess(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			registry.Register(Currency{Code: string(rune('A'+i)) + "XX"})
+		}(i)
+		go func() {
+			defer wg.Done()
+			registry.Lookup("AXX")
+		}()
+	}
+	wg.Wait()
+
+	if _, ok := registry.Lookup("JXX"); !ok {
+		t.Fatal("expected to find JXX")
+	}
+}
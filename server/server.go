@@ -0,0 +1,356 @@
+// Package server exposes go-prices calculations over HTTP, so services written in other languages
+// share the same rounding and discount semantics.
+//
+// Endpoints accept and return JSON, money is encoded as by goprices.Money.MarshalJSON and taxed money
+// as by goprices.TaxedMoney.MarshalJSON. The OpenAPI document is served at /openapi.json.
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+	"github.com/site-name/go-prices/internal/pricing"
+)
+
+//go:embed openapi.json
+var openAPI []byte
+
+const (
+	// maxBodySize is the maximum size of a request body
+	maxBodySize = 1 << 20
+	// maxParts is the maximum number of parts or ratios of an /allocate request
+	maxParts = 10000
+	// maxPlaces is the maximum number of decimal places of a /quantize request
+	maxPlaces = 28
+)
+
+// Options configures the handler returned by NewHandler
+type Options struct {
+	// Base and Rates are exchange rates used by the /convert endpoint: 1 Base is worth Rates[code] code,
+	// it fails when a rate is missing. Codes are case insensitive, Base is required when Rates are given.
+	// Create a new handler to update them.
+	Base  string
+	Rates map[string]decimal.Decimal
+}
+
+// NewHandler returns a handler serving endpoints:
+//
+//	POST /quantize
+//	POST /discount
+//	POST /tax
+//	POST /convert
+//	POST /allocate
+//	GET  /openapi.json
+//
+// Malformed requests are answered with 400, invalid values (unknown currency, negative amount...) with 422.
+// Error responses are like {"error":"unknown currency unit: \"ABC\"","code":"unknown_currency"}
+//
+// Returned error could be `nil`, or an error of pricing.NewExchangeRates if Options.Base or Options.Rates are invalid
+func NewHandler(options Options) (http.Handler, error) {
+	var rates pricing.ExchangeRates
+	if options.Base != "" || len(options.Rates) > 0 {
+		var err error
+		if rates, err = pricing.NewExchangeRates(options.Base, options.Rates); err != nil {
+			return nil, err
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/quantize", handle(quantize))
+	mux.Handle("/discount", handle(discount))
+	mux.Handle("/tax", handle(tax))
+	mux.Handle("/convert", handle(func(req convertRequest) (convertResponse, error) {
+		return convert(req, rates)
+	}))
+	mux.Handle("/allocate", handle(allocate))
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", errors.New("method not allowed"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPI)
+	})
+	return mux, nil
+}
+
+// errInvalidRequest is returned when a request misses a field or has an invalid one
+var errInvalidRequest = errors.New("invalid request")
+
+// requestError is a malformed request, it is answered with 400
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// errorCodes maps errors of invalid values to codes of 422 responses
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{goprices.ErrUnknownCurrency, "unknown_currency"},
+	{goprices.ErrNotSameCurrency, "currency_mismatch"},
+	{goprices.ErrMoneyNegative, "negative_amount"},
+	{goprices.ErrInvalidRounding, "invalid_rounding"},
+	{goprices.ErrPrecisionLoss, "precision_loss"},
+	{pricing.ErrInvalidAllocation, "invalid_allocation"},
+	{pricing.ErrInvalidTaxRate, "invalid_tax_rate"},
+	{pricing.ErrInvalidExchangeRate, "missing_exchange_rate"},
+	{goprices.ErrInvalidEncoding, "invalid_amount"},
+}
+
+// handle returns a handler decoding JSON requests of type Req, and encoding responses of fn
+func handle[Req, Res any](fn func(Req) (Res, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", errors.New("method not allowed"))
+			return
+		}
+
+		var req Req
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&req)
+		if err != nil {
+			err = &requestError{err}
+		} else {
+			var res Res
+			if res, err = fn(req); err == nil {
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(res)
+				return
+			}
+		}
+
+		for _, unit := range errorCodes {
+			if errors.Is(err, unit.err) {
+				writeError(w, http.StatusUnprocessableEntity, unit.code, err)
+				return
+			}
+		}
+		var reqErr *requestError
+		if errors.As(err, &reqErr) || errors.Is(err, errInvalidRequest) {
+			writeError(w, http.StatusBadRequest, "invalid_request", err)
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "internal", err)
+	})
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func writeError(w http.ResponseWriter, status int, code string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorResponse{err.Error(), code})
+}
+
+// price is either money or taxed money, taxed money is told apart by its net field
+type price struct {
+	money *goprices.Money
+	taxed *goprices.TaxedMoney
+}
+
+func (p price) MarshalJSON() ([]byte, error) {
+	if p.taxed != nil {
+		return json.Marshal(p.taxed)
+	}
+	return json.Marshal(p.money)
+}
+
+func (p *price) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Net json.RawMessage `json:"net"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	if probe.Net != nil {
+		p.taxed = new(goprices.TaxedMoney)
+		return json.Unmarshal(data, p.taxed)
+	}
+	p.money = new(goprices.Money)
+	return json.Unmarshal(data, p.money)
+}
+
+// validate checks that a price is given and is not negative
+func (p price) validate() error {
+	switch {
+	case p.taxed != nil && p.taxed.IsNegative(), p.money != nil && p.money.IsNegative():
+		return goprices.ErrMoneyNegative
+	case p.taxed == nil && p.money == nil:
+		return errInvalidRequest
+	}
+	return nil
+}
+
+// rounding is goprices.Rounding encoded by its name, it is goprices.Up when omitted
+type rounding goprices.Rounding
+
+var roundings = map[string]goprices.Rounding{
+	"up":    goprices.Up,
+	"down":  goprices.Down,
+	"ceil":  goprices.Ceil,
+	"floor": goprices.Floor,
+}
+
+func (r *rounding) UnmarshalText(text []byte) error {
+	value, ok := roundings[strings.ToLower(string(text))]
+	if !ok {
+		return goprices.ErrInvalidRounding
+	}
+	*r = rounding(value)
+	return nil
+}
+
+type quantizeRequest struct {
+	Price    price    `json:"price"`
+	Rounding rounding `json:"rounding"`
+	// Places defaults to currency precision
+	Places *int `json:"places"`
+}
+
+type priceResponse struct {
+	Price price `json:"price"`
+}
+
+func quantize(req quantizeRequest) (priceResponse, error) {
+	if err := req.Price.validate(); err != nil {
+		return priceResponse{}, err
+	}
+	places := -1
+	if req.Places != nil {
+		if *req.Places < 0 || *req.Places > maxPlaces {
+			return priceResponse{}, errInvalidRequest
+		}
+		places = *req.Places
+	}
+
+	var res price
+	var err error
+	if req.Price.taxed != nil {
+		res.taxed, err = req.Price.taxed.Quantize(goprices.Rounding(req.Rounding), places)
+	} else {
+		res.money, err = req.Price.money.Quantize(goprices.Rounding(req.Rounding), places)
+	}
+	return priceResponse{res}, err
+}
+
+type discountRequest struct {
+	Price     price           `json:"price"`
+	Percent   decimal.Decimal `json:"percent"`
+	FromGross bool            `json:"from_gross"`
+	Rounding  rounding        `json:"rounding"`
+	// Max caps the discounted amount, when given
+	Max *goprices.Money `json:"max"`
+}
+
+func discount(req discountRequest) (priceResponse, error) {
+	if err := req.Price.validate(); err != nil {
+		return priceResponse{}, err
+	}
+	if req.Percent.IsNegative() || req.Percent.GreaterThan(decimal.NewFromInt(100)) {
+		return priceResponse{}, errInvalidRequest
+	}
+	fraction := req.Percent.Shift(-2)
+
+	var res price
+	var err error
+	switch {
+	case req.Price.taxed != nil && req.Max != nil:
+		res.taxed, err = goprices.CappedFractionalDiscount(*req.Price.taxed, fraction, *req.Max, req.FromGross, goprices.Rounding(req.Rounding))
+	case req.Price.taxed != nil:
+		res.taxed, err = goprices.FractionalDiscount(*req.Price.taxed, fraction, req.FromGross, goprices.Rounding(req.Rounding))
+	case req.Max != nil:
+		res.money, err = goprices.CappedFractionalDiscount(*req.Price.money, fraction, *req.Max, req.FromGross, goprices.Rounding(req.Rounding))
+	default:
+		res.money, err = goprices.FractionalDiscount(*req.Price.money, fraction, req.FromGross, goprices.Rounding(req.Rounding))
+	}
+	return priceResponse{res}, err
+}
+
+type taxRequest struct {
+	Price     goprices.Money  `json:"price"`
+	Percent   decimal.Decimal `json:"percent"`
+	FromGross bool            `json:"from_gross"`
+}
+
+type taxResponse struct {
+	Price goprices.TaxedMoney `json:"price"`
+}
+
+func tax(req taxRequest) (taxResponse, error) {
+	if req.Price.GetCurrency() == "" {
+		return taxResponse{}, errInvalidRequest
+	}
+	if req.Price.IsNegative() {
+		return taxResponse{}, goprices.ErrMoneyNegative
+	}
+	res, err := pricing.FlatTax(req.Price, req.Percent.Shift(-2), req.FromGross)
+	if err != nil {
+		return taxResponse{}, err
+	}
+	return taxResponse{*res}, nil
+}
+
+type convertRequest struct {
+	Money goprices.Money `json:"money"`
+	To    string         `json:"to"`
+}
+
+type convertResponse struct {
+	Money goprices.Money `json:"money"`
+}
+
+func convert(req convertRequest, rates pricing.ExchangeRates) (convertResponse, error) {
+	if req.Money.GetCurrency() == "" || req.To == "" {
+		return convertResponse{}, errInvalidRequest
+	}
+	res, err := pricing.Convert(req.Money, req.To, rates)
+	if err != nil {
+		return convertResponse{}, err
+	}
+	return convertResponse{*res}, nil
+}
+
+type allocateRequest struct {
+	Money goprices.Money `json:"money"`
+	// Ratios of parts, or Parts equal parts when Ratios are omitted
+	Ratios []int `json:"ratios"`
+	Parts  int   `json:"parts"`
+}
+
+type allocateResponse struct {
+	Parts []goprices.Money `json:"parts"`
+}
+
+func allocate(req allocateRequest) (allocateResponse, error) {
+	if req.Money.GetCurrency() == "" || (req.Ratios == nil && req.Parts == 0) || req.Parts > maxParts || len(req.Ratios) > maxParts {
+		return allocateResponse{}, errInvalidRequest
+	}
+	var parts []goprices.Money
+	var err error
+	if req.Ratios != nil {
+		parts, err = pricing.Allocate(req.Money, req.Ratios...)
+	} else {
+		parts, err = pricing.Split(req.Money, req.Parts)
+	}
+	return allocateResponse{parts}, err
+}
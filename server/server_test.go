@@ -0,0 +1,157 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/site-name/decimal"
+	goprices "github.com/site-name/go-prices"
+	"github.com/site-name/go-prices/internal/pricing"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	options := Options{
+		Base:  goprices.USD,
+		Rates: map[string]decimal.Decimal{"eur": decimal.RequireFromString("0.92")},
+	}
+	handler, err := NewHandler(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEndpoints(t *testing.T) {
+	server := newTestServer(t)
+
+	type testUnit struct {
+		path     string
+		body     string
+		status   int
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{"/quantize", `{"price":{"amount":"1.005","currency":"USD"},"rounding":"down"}`, 200,
+			`{"price":{"amount":"1","currency":"USD"}}`},
+		{"/quantize", `{"price":{"net":{"amount":"1.001","currency":"USD"},"gross":{"amount":"1.201","currency":"USD"}},"places":1}`, 200,
+			`{"price":{"net":{"amount":"1.1","currency":"USD"},"gross":{"amount":"1.3","currency":"USD"}}}`},
+		{"/discount", `{"price":{"amount":"10","currency":"USD"},"percent":15}`, 200,
+			`{"price":{"amount":"8.5","currency":"USD"}}`},
+		{"/discount", `{"price":{"net":{"amount":"10","currency":"USD"},"gross":{"amount":"12","currency":"USD"}},"percent":"15","from_gross":true}`, 200,
+			`{"price":{"net":{"amount":"8.2","currency":"USD"},"gross":{"amount":"10.2","currency":"USD"}}}`},
+		{"/discount", `{"price":{"amount":"100","currency":"USD"},"percent":"20","max":{"amount":"15","currency":"USD"}}`, 200,
+			`{"price":{"amount":"85","currency":"USD"}}`},
+		{"/tax", `{"price":{"amount":"10","currency":"USD"},"percent":"20","from_gross":true}`, 200,
+			`{"price":{"net":{"amount":"8.33","currency":"USD"},"gross":{"amount":"10","currency":"USD"}}}`},
+		{"/convert", `{"money":{"amount":"10","currency":"EUR"},"to":"USD"}`, 200,
+			`{"money":{"amount":"10.87","currency":"USD"}}`},
+		{"/allocate", `{"money":{"amount":"10","currency":"USD"},"parts":3}`, 200,
+			`{"parts":[{"amount":"3.34","currency":"USD"},{"amount":"3.33","currency":"USD"},{"amount":"3.33","currency":"USD"}]}`},
+		{"/allocate", `{"money":{"amount":"0.05","currency":"USD"},"ratios":[3,7]}`, 200,
+			`{"parts":[{"amount":"0.02","currency":"USD"},{"amount":"0.03","currency":"USD"}]}`},
+
+		{"/quantize", `{"price":`, 400, "invalid_request"},
+		{"/quantize", `{"price":{"amount":"1","currency":"USD"},"unknown":1}`, 400, "invalid_request"},
+		{"/quantize", `{"rounding":"up"}`, 400, "invalid_request"},
+		{"/quantize", `{"price":{"amount":"1","currency":"USD"},"places":29}`, 400, "invalid_request"},
+		{"/quantize", `{"price":{"amount":"1","currency":"USD"},"places":2147483648}`, 400, "invalid_request"},
+		{"/quantize", `{"price":{"amount":"1","currency":"USD"},"places":4294967298}`, 400, "invalid_request"},
+		{"/quantize", `{"price":{"amount":"1","currency":"USD"},"rounding":"sideways"}`, 422, "invalid_rounding"},
+		{"/discount", `{"price":{"amount":"1","currency":"ABC"},"percent":1}`, 422, "unknown_currency"},
+		{"/discount", `{"price":{"amount":"-1","currency":"USD"},"percent":1}`, 422, "negative_amount"},
+		{"/discount", `{"price":{"amount":"1","currency":"USD"},"percent":101}`, 400, "invalid_request"},
+		{"/tax", `{"price":{"amount":"1","currency":"USD"},"percent":-1}`, 422, "invalid_tax_rate"},
+		{"/convert", `{"money":{"amount":"10","currency":"GBP"},"to":"USD"}`, 422, "missing_exchange_rate"},
+		{"/allocate", `{"money":{"amount":"10","currency":"USD"},"ratios":[0]}`, 422, "invalid_allocation"},
+		{"/allocate", `{"money":{"amount":"0.001","currency":"USD"},"parts":2}`, 422, "precision_loss"},
+		{"/allocate", `{"money":{"amount":"10","currency":"USD"},"parts":10001}`, 400, "invalid_request"},
+		{"/allocate", `{"money":{"amount":"10","currency":"USD"},"parts":4294967296}`, 400, "invalid_request"},
+	} {
+		res, err := http.Post(server.URL+unit.path, "application/json", strings.NewReader(unit.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode != unit.status {
+			t.Fatalf("Error at index: %d, expected status: %d, got: %d, body: %s", index, unit.status, res.StatusCode, body)
+		}
+
+		got := strings.TrimSpace(string(body))
+		if unit.status != http.StatusOK {
+			var errRes errorResponse
+			if err := json.Unmarshal(body, &errRes); err != nil {
+				t.Fatal(err)
+			}
+			got = errRes.Code
+		}
+		if got != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, got)
+		}
+	}
+}
+
+func TestNewHandlerOptions(t *testing.T) {
+	type testUnit struct {
+		options Options
+		err     error
+	}
+	for index, unit := range []testUnit{
+		{Options{}, nil},
+		{Options{Base: "usd"}, nil},
+		{Options{Rates: map[string]decimal.Decimal{goprices.EUR: decimal.NewFromInt(1)}}, goprices.ErrUnknownCurrency},
+		{Options{Base: goprices.USD, Rates: map[string]decimal.Decimal{"ABC": decimal.NewFromInt(1)}}, goprices.ErrUnknownCurrency},
+		{Options{Base: goprices.USD, Rates: map[string]decimal.Decimal{goprices.EUR: decimal.Zero}}, pricing.ErrInvalidExchangeRate},
+	} {
+		if _, err := NewHandler(unit.options); !errors.Is(err, unit.err) {
+			t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+		}
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	server := newTestServer(t)
+	res, err := http.Get(server.URL + "/quantize")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusMethodNotAllowed || res.Header.Get("Allow") != http.MethodPost {
+		t.Fatalf("expected 405 allowing POST, got: %d, %q", res.StatusCode, res.Header.Get("Allow"))
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	server := newTestServer(t)
+	res, err := http.Get(server.URL + "/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got: %d", res.StatusCode)
+	}
+
+	var doc struct {
+		OpenAPI string                     `json:"openapi"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/quantize", "/discount", "/tax", "/convert", "/allocate"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Fatalf("path %s is not documented", path)
+		}
+	}
+}
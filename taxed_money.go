@@ -2,6 +2,7 @@ package goprices
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/site-name/decimal"
 )
@@ -15,22 +16,35 @@ type TaxedMoney struct {
 var _ MoneyInterface[TaxedMoney] = (*TaxedMoney)(nil)
 
 // NewTaxedMoney returns new TaxedMoney,
-// If net and gross have different currency type, return nil and error
+// If net and gross have different currency type, return nil and error.
+// Negative net or gross is rejected with ErrMoneyNegative, use NewSignedTaxedMoney to allow it.
 func NewTaxedMoney(net, gross Money) (*TaxedMoney, error) {
+	if net.IsNegative() || gross.IsNegative() {
+		return nil, ErrMoneyNegative
+	}
+	return ptr(newTaxedMoney(net, gross))
+}
+
+// NewSignedTaxedMoney is like NewTaxedMoney but accepts negative net and gross.
+func NewSignedTaxedMoney(net, gross Money) (*TaxedMoney, error) {
+	return ptr(newTaxedMoney(net, gross))
+}
+
+func newTaxedMoney(net, gross Money) (TaxedMoney, error) {
 	unit1, err := validateCurrency(net.currency)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
 	unit2, err := validateCurrency(gross.currency)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
 
 	if unit1 != unit2 {
-		return nil, ErrNotSameCurrency
+		return TaxedMoney{}, &CurrencyMismatchError{Left: unit1, Right: unit2}
 	}
 
-	return &TaxedMoney{net, gross}, nil
+	return TaxedMoney{net, gross}, nil
 }
 
 func NewTaxedMoneyFromFloats(net, gross float64, currency string) (*TaxedMoney, error) {
@@ -98,18 +112,52 @@ func (t TaxedMoney) LessThanOrEqual(other TaxedMoney) bool {
 	return t.LessThan(other) || t.Equal(other)
 }
 
+// GreaterThan check if this money's gross is greater than other's gross
+func (t TaxedMoney) GreaterThan(other TaxedMoney) bool {
+	return t.gross.GreaterThan(other.gross)
+}
+
+// GreaterThanOrEqual checks if this money is greater than or equal to other.
+func (t TaxedMoney) GreaterThanOrEqual(other TaxedMoney) bool {
+	return t.GreaterThan(other) || t.Equal(other)
+}
+
+// Compare compares gross (if fromGross is true) or net of this money and other's.
+// See Money.Compare
+func (t TaxedMoney) Compare(other TaxedMoney, fromGross bool) (int, error) {
+	if fromGross {
+		return t.gross.Compare(other.gross)
+	}
+	return t.net.Compare(other.net)
+}
+
 // Mul multiplies current taxed money with given other
 //
-// other must only be either ints or floats or Decimal
+// NOTE: other is converted to decimal, use MulDecimal to avoid binary float errors.
 func (t TaxedMoney) Mul(other float64) TaxedMoney {
+	return t.MulDecimal(decimal.NewFromFloat(other))
+}
+
+// MulDecimal multiplies current taxed money with given other, without any precision loss.
+func (t TaxedMoney) MulDecimal(other decimal.Decimal) TaxedMoney {
 	return TaxedMoney{
-		net:   t.net.Mul(other),
-		gross: t.gross.Mul(other),
+		net:   t.net.MulDecimal(other),
+		gross: t.gross.MulDecimal(other),
+	}
+}
+
+// MulRat multiplies current taxed money with given exact fraction.
+// See Money.MulRat
+func (t TaxedMoney) MulRat(other *big.Rat) TaxedMoney {
+	return TaxedMoney{
+		net:   t.net.MulRat(other),
+		gross: t.gross.MulRat(other),
 	}
 }
 
 // TrueDiv divides current tabled money to other.
-// other must be either Decimal or ints or floats
+//
+// NOTE: other is converted to decimal, use DivDecimal to avoid binary float errors.
 func (t TaxedMoney) TrueDiv(other float64) TaxedMoney {
 	return TaxedMoney{
 		gross: t.gross.TrueDiv(other),
@@ -117,38 +165,65 @@ func (t TaxedMoney) TrueDiv(other float64) TaxedMoney {
 	}
 }
 
+// DivDecimal divides current taxed money to given other.
+// If returned error is not nil, it is ErrDivisorZero
+func (t TaxedMoney) DivDecimal(other decimal.Decimal) (*TaxedMoney, error) {
+	return ptr(t.divDecimal(other))
+}
+
+func (t TaxedMoney) divDecimal(other decimal.Decimal) (TaxedMoney, error) {
+	net, err := t.net.divDecimal(other)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	gross, err := t.gross.divDecimal(other)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	return TaxedMoney{net, gross}, nil
+}
+
 // Add adds a money or taxed money to this.
 // other must be either Money or TaxedMoney
 func (t TaxedMoney) Add(other any) (*TaxedMoney, error) {
+	return ptr(t.add(other))
+}
+
+// MustAdd is like Add but returns a value and panics if error occurs.
+func (t TaxedMoney) MustAdd(other any) TaxedMoney {
+	return must(t.add(other))
+}
+
+func (t TaxedMoney) add(other any) (TaxedMoney, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return TaxedMoney{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money:
-		net, err := t.net.Add(v)
+		net, err := t.net.add(v)
 		if err != nil {
-			return nil, err
+			return TaxedMoney{}, err
 		}
-		gross, err := t.gross.Add(v)
+		gross, err := t.gross.add(v)
 		if err != nil {
-			return nil, err
+			return TaxedMoney{}, err
 		}
-		return &TaxedMoney{*net, *gross}, nil
+		return TaxedMoney{net, gross}, nil
 
 	case TaxedMoney:
-		net, err := t.net.Add(v.net)
+		net, err := t.net.add(v.net)
 		if err != nil {
-			return nil, err
+			return TaxedMoney{}, err
 		}
-		gross, err := t.gross.Add(v.gross)
+		gross, err := t.gross.add(v.gross)
 		if err != nil {
-			return nil, err
+			return TaxedMoney{}, err
 		}
-		return &TaxedMoney{*net, *gross}, nil
+		return TaxedMoney{net, gross}, nil
 
 	default:
-		return nil, ErrUnknownType
+		return TaxedMoney{}, ErrUnknownType
 	}
 }
 
@@ -159,21 +234,53 @@ func (t TaxedMoney) Neg() TaxedMoney {
 	}
 }
 
+// IsNegative checks if net or gross of current taxed money is less than zero
+func (t TaxedMoney) IsNegative() bool {
+	return t.net.IsNegative() || t.gross.IsNegative()
+}
+
+// IsZero checks if both net and gross of current taxed money are zero
+func (t TaxedMoney) IsZero() bool {
+	return t.net.IsZero() && t.gross.IsZero()
+}
+
+// IsPositive checks if both net and gross of current taxed money are greater than zero
+func (t TaxedMoney) IsPositive() bool {
+	return t.net.IsPositive() && t.gross.IsPositive()
+}
+
+// Abs returns a taxed money with absolute values of net and gross
+func (t TaxedMoney) Abs() TaxedMoney {
+	return TaxedMoney{
+		net:   t.net.Abs(),
+		gross: t.gross.Abs(),
+	}
+}
+
 // Add substract this money to other.
 // other must be either Money or TaxedMoney.
 func (t TaxedMoney) Sub(other any) (*TaxedMoney, error) {
+	return ptr(t.sub(other))
+}
+
+// MustSub is like Sub but returns a value and panics if error occurs.
+func (t TaxedMoney) MustSub(other any) TaxedMoney {
+	return must(t.sub(other))
+}
+
+func (t TaxedMoney) sub(other any) (TaxedMoney, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return TaxedMoney{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money:
-		return t.Add(v.Neg())
+		return t.add(v.Neg())
 	case TaxedMoney:
-		return t.Add(v.Neg())
+		return t.add(v.Neg())
 
 	default:
-		return nil, ErrUnknownType
+		return TaxedMoney{}, ErrUnknownType
 	}
 }
 
@@ -186,35 +293,44 @@ func (t TaxedMoney) Tax() *Money {
 // Return a new instance with both net and gross quantized.
 // All arguments are passed to `Money.quantize
 func (t TaxedMoney) Quantize(round Rounding, exp int) (*TaxedMoney, error) {
-	net, err := t.net.Quantize(round, exp)
+	return ptr(t.quantize(round, exp))
+}
+
+// MustQuantize is like Quantize but returns a value and panics if error occurs.
+func (t TaxedMoney) MustQuantize(round Rounding, exp int) TaxedMoney {
+	return must(t.quantize(round, exp))
+}
+
+func (t TaxedMoney) quantize(round Rounding, exp int) (TaxedMoney, error) {
+	net, err := t.net.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
-	gross, err := t.gross.Quantize(round, exp)
+	gross, err := t.gross.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
 
-	return &TaxedMoney{
-		net:   *net,
-		gross: *gross,
+	return TaxedMoney{
+		net:   net,
+		gross: gross,
 	}, nil
 }
 
 // Apply a fixed discount to TaxedMoney.
-func (t TaxedMoney) fixedDiscount(discount Money) (*TaxedMoney, error) {
+func (t TaxedMoney) fixedDiscount(discount Money) (TaxedMoney, error) {
 	baseNet, err := t.net.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
 	baseGross, err := t.gross.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
 	}
-	return NewTaxedMoney(*baseNet, *baseGross)
+	return newTaxedMoney(baseNet, baseGross)
 }
 
-func (m TaxedMoney) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (*TaxedMoney, error) {
+func (m TaxedMoney) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (TaxedMoney, error) {
 	op := Money{
 		currency: m.GetCurrency(),
 		amount:   m.gross.amount,
@@ -223,11 +339,36 @@ func (m TaxedMoney) fractionalDiscount(fraction decimal.Decimal, fromGross bool,
 		op.amount = m.net.amount
 	}
 
-	op = op.Mul(fraction.InexactFloat64())
-	discount, err := op.Quantize(rounding, -1)
+	op = op.MulDecimal(fraction)
+	discount, err := op.quantize(rounding, -1)
 	if err != nil {
-		return nil, err
+		return TaxedMoney{}, err
+	}
+
+	return m.fixedDiscount(discount)
+}
+
+func (m TaxedMoney) cappedFractionalDiscount(fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (TaxedMoney, error) {
+	if !m.net.SameKind(max) {
+		return TaxedMoney{}, &CurrencyMismatchError{Left: m.GetCurrency(), Right: max.currency}
+	}
+
+	op := Money{
+		currency: m.GetCurrency(),
+		amount:   m.gross.amount,
+	}
+	if !fromGross {
+		op.amount = m.net.amount
+	}
+
+	op = op.MulDecimal(fraction)
+	discount, err := op.quantize(rounding, -1)
+	if err != nil {
+		return TaxedMoney{}, err
+	}
+	if max.LessThan(discount) {
+		discount = max
 	}
 
-	return m.fixedDiscount(*discount)
+	return m.fixedDiscount(discount)
 }
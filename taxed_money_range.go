@@ -1,14 +1,18 @@
 package goprices
 
 import (
-	"fmt"
+	"math/big"
 
 	"github.com/site-name/decimal"
 )
 
+// TaxedMoneyRange has start and stop ends. Each end is either Inclusive, Exclusive or Unbounded, see Bound.
+// Ends are ordered by gross.
 type TaxedMoneyRange struct {
-	start TaxedMoney
-	stop  TaxedMoney
+	start      TaxedMoney
+	stop       TaxedMoney
+	startBound Bound
+	stopBound  Bound
 }
 
 var _ MoneyInterface[TaxedMoneyRange] = (*TaxedMoneyRange)(nil)
@@ -21,41 +25,121 @@ func (t TaxedMoneyRange) GetStop() TaxedMoney {
 	return t.stop
 }
 
-// NewTaxedMoneyRange create new taxed money range.
-// It returns nil and error value if start > stop or they have different currencies
+// NewTaxedMoneyRange create new closed taxed money range [start, stop].
+// It returns nil and error value if start > stop or they have different currencies.
+// Negative net or gross of any end is rejected with ErrMoneyNegative, use NewSignedTaxedMoneyRange to allow it.
 func NewTaxedMoneyRange(start, stop TaxedMoney) (*TaxedMoneyRange, error) {
+	return NewTaxedMoneyRangeWithBounds(start, Inclusive, stop, Inclusive)
+}
+
+// NewSignedTaxedMoneyRange is like NewTaxedMoneyRange but accepts negative ends.
+func NewSignedTaxedMoneyRange(start, stop TaxedMoney) (*TaxedMoneyRange, error) {
+	return ptr(newTaxedMoneyRange(start, stop, Inclusive, Inclusive))
+}
+
+// NewTaxedMoneyRangeWithBounds returns a new taxed money range with given bounds.
+// See NewMoneyRangeWithBounds
+func NewTaxedMoneyRangeWithBounds(start TaxedMoney, startBound Bound, stop TaxedMoney, stopBound Bound) (*TaxedMoneyRange, error) {
+	if (startBound != Unbounded && start.IsNegative()) || (stopBound != Unbounded && stop.IsNegative()) {
+		return nil, ErrMoneyNegative
+	}
+	return ptr(newTaxedMoneyRange(start, stop, startBound, stopBound))
+}
+
+// NewTaxedMoneyRangeFrom returns a taxed money range without stop, e.g: [20, +inf)
+func NewTaxedMoneyRangeFrom(start TaxedMoney, bound Bound) (*TaxedMoneyRange, error) {
+	return NewTaxedMoneyRangeWithBounds(start, bound, zeroOf[TaxedMoney](start.GetCurrency()), Unbounded)
+}
+
+// NewTaxedMoneyRangeTo returns a taxed money range without start, e.g: (-inf, 100)
+func NewTaxedMoneyRangeTo(stop TaxedMoney, bound Bound) (*TaxedMoneyRange, error) {
+	return NewTaxedMoneyRangeWithBounds(zeroOf[TaxedMoney](stop.GetCurrency()), Unbounded, stop, bound)
+}
+
+func newTaxedMoneyRange(start, stop TaxedMoney, startBound, stopBound Bound) (TaxedMoneyRange, error) {
+	if !startBound.valid() || !stopBound.valid() {
+		return TaxedMoneyRange{}, ErrInvalidBound
+	}
+	if startBound == Unbounded && start.GetCurrency() == "" {
+		start = zeroOf[TaxedMoney](stop.GetCurrency())
+	}
+	if stopBound == Unbounded && stop.GetCurrency() == "" {
+		stop = zeroOf[TaxedMoney](start.GetCurrency())
+	}
+
 	startUnit, err := validateCurrency(start.GetCurrency())
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
 	stopUnit, err := validateCurrency(stop.GetCurrency())
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
 	if startUnit != stopUnit {
-		return nil, ErrNotSameCurrency
+		return TaxedMoneyRange{}, &CurrencyMismatchError{Left: startUnit, Right: stopUnit}
 	}
-	if start.net.amount.LessThan(decimal.Zero) || stop.gross.amount.LessThan(decimal.Zero) {
-		return nil, ErrMoneyNegative
+
+	res := TaxedMoneyRange{startBound: startBound, stopBound: stopBound}.withEnds(start, stop)
+	if empty, _ := isEmpty(res.startEnd(), res.stopEnd(), taxedCompare(true)); empty {
+		return TaxedMoneyRange{}, &InvalidRangeError{Start: start, Stop: stop}
 	}
+	return res, nil
+}
 
-	if stop.LessThan(start) {
-		return nil, ErrStopLessThanStart
+// withEnds returns a range with given ends and bounds of current taxed money range.
+// Values of unbounded ends are reset to zero.
+func (t TaxedMoneyRange) withEnds(start, stop TaxedMoney) TaxedMoneyRange {
+	if t.startBound == Unbounded {
+		start = zeroOf[TaxedMoney](start.GetCurrency())
+	}
+	if t.stopBound == Unbounded {
+		stop = zeroOf[TaxedMoney](stop.GetCurrency())
+	}
+	return TaxedMoneyRange{
+		start:      start,
+		stop:       stop,
+		startBound: t.startBound,
+		stopBound:  t.stopBound,
 	}
+}
+
+func (t TaxedMoneyRange) startEnd() rangeEnd[TaxedMoney] {
+	return rangeEnd[TaxedMoney]{t.start, t.startBound}
+}
+
+func (t TaxedMoneyRange) stopEnd() rangeEnd[TaxedMoney] {
+	return rangeEnd[TaxedMoney]{t.stop, t.stopBound}
+}
+
+// StartBound returns bound of current taxed money range's start
+func (t TaxedMoneyRange) StartBound() Bound {
+	return t.startBound
+}
+
+// StopBound returns bound of current taxed money range's stop
+func (t TaxedMoneyRange) StopBound() Bound {
+	return t.stopBound
+}
 
-	return &TaxedMoneyRange{start, stop}, nil
+// IsBounded checks if current taxed money range has both start and stop
+func (t TaxedMoneyRange) IsBounded() bool {
+	return t.startBound != Unbounded && t.stopBound != Unbounded
 }
 
+// WithBounds returns a copy of current taxed money range with given bounds.
+// An end becoming bounded after being Unbounded has zero value.
+func (t TaxedMoneyRange) WithBounds(startBound, stopBound Bound) (*TaxedMoneyRange, error) {
+	return ptr(newTaxedMoneyRange(t.start, t.stop, startBound, stopBound))
+}
+
+// Neg returns the range of negated values of current taxed money range. See MoneyRange.Neg
 func (t TaxedMoneyRange) Neg() TaxedMoneyRange {
-	return TaxedMoneyRange{
-		start: t.start.Neg(),
-		stop:  t.stop.Neg(),
-	}
+	return TaxedMoneyRange{startBound: t.stopBound, stopBound: t.startBound}.withEnds(t.stop.Neg(), t.start.Neg())
 }
 
-// String implements fmt.Stringer interface
+// String implements fmt.Stringer interface, using interval notation. See MoneyRange.String
 func (t TaxedMoneyRange) String() string {
-	return fmt.Sprintf("TaxedMoneyRange{%s, %s}", t.start.String(), t.stop.String())
+	return "TaxedMoneyRange" + formatRange(t.startEnd(), t.stopEnd())
 }
 
 func (m *TaxedMoneyRange) SetStart(start TaxedMoney) {
@@ -72,76 +156,153 @@ func (t TaxedMoneyRange) GetCurrency() string {
 }
 
 // Add adds this taxed money range to another value
+// When other is a range, an end of the result is Unbounded or Exclusive if any of the added ends is.
+//
 // other must be either: Money, MoneyRange or TaxedMoneyRange or TaxedMoney
 func (t TaxedMoneyRange) Add(other any) (*TaxedMoneyRange, error) {
+	return ptr(t.add(other))
+}
+
+// MustAdd is like Add but returns a value and panics if error occurs.
+func (t TaxedMoneyRange) MustAdd(other any) TaxedMoneyRange {
+	return must(t.add(other))
+}
+
+func (t TaxedMoneyRange) add(other any) (TaxedMoneyRange, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return TaxedMoneyRange{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money, TaxedMoney:
-		start, err := t.start.Add(v)
+		start, err := t.start.add(v)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		stop, err := t.stop.Add(v)
+		stop, err := t.stop.add(v)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		return &TaxedMoneyRange{*start, *stop}, nil
+		return t.withEnds(start, stop), nil
 
 	case MoneyRange:
-		start, err := t.start.Add(v.start)
+		start, err := t.start.add(v.start)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		stop, err := t.stop.Add(v.stop)
+		stop, err := t.stop.add(v.stop)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		return &TaxedMoneyRange{*start, *stop}, nil
+		return t.withCombinedBounds(v.startBound, v.stopBound).withEnds(start, stop), nil
 
 	case TaxedMoneyRange:
-		start, err := t.start.Add(v.start)
+		start, err := t.start.add(v.start)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		stop, err := t.stop.Add(v.stop)
+		stop, err := t.stop.add(v.stop)
 		if err != nil {
-			return nil, err
+			return TaxedMoneyRange{}, err
 		}
-		return &TaxedMoneyRange{*start, *stop}, nil
+		return t.withCombinedBounds(v.startBound, v.stopBound).withEnds(start, stop), nil
 
 	default:
-		return nil, ErrUnknownType
+		return TaxedMoneyRange{}, ErrUnknownType
 	}
 }
 
+// withCombinedBounds returns a copy of current taxed money range with bounds combined with given ones, see combineBounds
+func (t TaxedMoneyRange) withCombinedBounds(startBound, stopBound Bound) TaxedMoneyRange {
+	t.startBound = combineBounds(t.startBound, startBound)
+	t.stopBound = combineBounds(t.stopBound, stopBound)
+	return t
+}
+
 // Sub substract this taxed money range to given other.
-// other must be either Money or TaxedMoney or MoneyRange or TaxedMoneyRange
+// other must be either Money or TaxedMoney or MoneyRange or TaxedMoneyRange.
+// Ranges are subtracted end by end, see MoneyRange.Sub
 func (t TaxedMoneyRange) Sub(other any) (*TaxedMoneyRange, error) {
+	return ptr(t.sub(other))
+}
+
+// MustSub is like Sub but returns a value and panics if error occurs.
+func (t TaxedMoneyRange) MustSub(other any) TaxedMoneyRange {
+	return must(t.sub(other))
+}
+
+func (t TaxedMoneyRange) sub(other any) (TaxedMoneyRange, error) {
 	if other == nil {
-		return nil, ErrNillValue
+		return TaxedMoneyRange{}, ErrNillValue
 	}
 
 	switch v := other.(type) {
 	case Money:
-		return t.Add(v.Neg())
+		return t.add(v.Neg())
 	case TaxedMoney:
-		return t.Add(v.Neg())
+		return t.add(v.Neg())
 	case MoneyRange:
-		return t.Add(v.Neg())
+		start, err := t.start.sub(v.start)
+		if err != nil {
+			return TaxedMoneyRange{}, err
+		}
+		stop, err := t.stop.sub(v.stop)
+		if err != nil {
+			return TaxedMoneyRange{}, err
+		}
+		return t.withCombinedBounds(v.startBound, v.stopBound).withEnds(start, stop), nil
+
 	case TaxedMoneyRange:
-		return t.Add(v.Neg())
+		start, err := t.start.sub(v.start)
+		if err != nil {
+			return TaxedMoneyRange{}, err
+		}
+		stop, err := t.stop.sub(v.stop)
+		if err != nil {
+			return TaxedMoneyRange{}, err
+		}
+		return t.withCombinedBounds(v.startBound, v.stopBound).withEnds(start, stop), nil
 
 	default:
-		return nil, ErrUnknownType
+		return TaxedMoneyRange{}, ErrUnknownType
+	}
+}
+
+// IsNegative checks if net or gross of any end of current taxed money range is less than zero,
+// or if current taxed money range has no start
+func (t TaxedMoneyRange) IsNegative() bool {
+	return t.startBound == Unbounded || t.start.IsNegative() || (t.stopBound != Unbounded && t.stop.IsNegative())
+}
+
+// IsZero checks if both start and stop of current taxed money range are zero
+func (t TaxedMoneyRange) IsZero() bool {
+	return t.IsBounded() && t.start.IsZero() && t.stop.IsZero()
+}
+
+// IsPositive checks if net and gross of all values of current taxed money range are greater than zero
+func (t TaxedMoneyRange) IsPositive() bool {
+	return t.startBound != Unbounded &&
+		(t.start.IsPositive() || (t.start.IsZero() && t.startBound == Exclusive)) &&
+		(t.stopBound == Unbounded || t.stop.IsPositive())
+}
+
+// Abs returns the range of absolute values of current taxed money range.
+// Net and gross are handled separately, the result has bounds computed from gross. See MoneyRange.Abs
+func (t TaxedMoneyRange) Abs() TaxedMoneyRange {
+	startNet, stopNet := absInterval(rangeEnd[Money]{t.start.net, t.startBound}, rangeEnd[Money]{t.stop.net, t.stopBound})
+	startGross, stopGross := absInterval(rangeEnd[Money]{t.start.gross, t.startBound}, rangeEnd[Money]{t.stop.gross, t.stopBound})
+	return TaxedMoneyRange{
+		start:      TaxedMoney{startNet.value, startGross.value},
+		stop:       TaxedMoney{stopNet.value, stopGross.value},
+		startBound: startGross.bound,
+		stopBound:  stopGross.bound,
 	}
 }
 
-// Equal compares two taxed money range
+// Equal compares two taxed money range, bounds included
 func (t TaxedMoneyRange) Equal(other TaxedMoneyRange) bool {
-	return t.start.Equal(other.start) && t.stop.Equal(other.stop)
+	return t.start.Equal(other.start) && t.stop.Equal(other.stop) &&
+		t.startBound == other.startBound && t.stopBound == other.stopBound
 }
 
 // LessThan checks if current taxed money range less than given other
@@ -154,31 +315,43 @@ func (t TaxedMoneyRange) LessThanOrEqual(other TaxedMoneyRange) bool {
 	return t.LessThan(other) || t.Equal(other)
 }
 
-// Contains check is given taxed money is in range from start to stop.
+// IsDegenerate checks if start and stop of current taxed money range are equal and inclusive,
+// meaning the range holds a single value only
+func (t TaxedMoneyRange) IsDegenerate() bool {
+	return t.startBound == Inclusive && t.stopBound == Inclusive && t.start.Equal(t.stop)
+}
+
+// Contains check is given taxed money is in range from start to stop, comparing gross and respecting bounds.
 //
 // start <= item <= stop
 func (t TaxedMoneyRange) Contains(item TaxedMoney) bool {
-	return t.start.LessThanOrEqual(item) && item.LessThanOrEqual(t.stop)
+	return contains(t.startEnd(), t.stopEnd(), item, taxedCompare(true))
 }
 
 // Return a copy of the range with start and stop quantized.
 // NOTE: if exp < 0; default will be used
 func (t TaxedMoneyRange) Quantize(round Rounding, exp int) (*TaxedMoneyRange, error) {
-	start, err := t.start.Quantize(round, exp)
+	return ptr(t.quantize(round, exp))
+}
+
+// MustQuantize is like Quantize but returns a value and panics if error occurs.
+func (t TaxedMoneyRange) MustQuantize(round Rounding, exp int) TaxedMoneyRange {
+	return must(t.quantize(round, exp))
+}
+
+func (t TaxedMoneyRange) quantize(round Rounding, exp int) (TaxedMoneyRange, error) {
+	start, err := t.start.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
-	stop, err := t.stop.Quantize(round, exp)
+	stop, err := t.stop.quantize(round, exp)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
-	return &TaxedMoneyRange{
-		start: *start,
-		stop:  *stop,
-	}, nil
+	return t.withEnds(start, stop), nil
 }
 
-// Return a range with start or stop replaced with given values
+// Return a range with start or stop replaced with given values, bounds are kept
 func (t TaxedMoneyRange) Replace(start, stop *TaxedMoney) (*TaxedMoneyRange, error) {
 	if start == nil {
 		start = &t.start
@@ -187,46 +360,86 @@ func (t TaxedMoneyRange) Replace(start, stop *TaxedMoney) (*TaxedMoneyRange, err
 		stop = &t.stop
 	}
 
-	return NewTaxedMoneyRange(*start, *stop)
+	return NewTaxedMoneyRangeWithBounds(*start, t.startBound, *stop, t.stopBound)
 }
 
 // Apply a fixed discount to TaxedMoneyRange.
-func (t TaxedMoneyRange) fixedDiscount(discount Money) (*TaxedMoneyRange, error) {
+func (t TaxedMoneyRange) fixedDiscount(discount Money) (TaxedMoneyRange, error) {
 	baseStart, err := t.start.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
 	baseStop, err := t.stop.fixedDiscount(discount)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
-	return NewTaxedMoneyRange(*baseStart, *baseStop)
+	return newTaxedMoneyRange(baseStart, baseStop, t.startBound, t.stopBound)
 }
 
+// Mul multiplies both ends of current taxed money range with given other.
+//
+// NOTE: other is converted to decimal, use MulDecimal to avoid binary float errors.
 func (t TaxedMoneyRange) Mul(other float64) TaxedMoneyRange {
-	return TaxedMoneyRange{
-		start: t.start.Mul(other),
-		stop:  t.stop.Mul(other),
-	}
+	return t.MulDecimal(decimal.NewFromFloat(other))
+}
+
+// MulDecimal multiplies both ends of current taxed money range with given other, without any precision loss.
+func (t TaxedMoneyRange) MulDecimal(other decimal.Decimal) TaxedMoneyRange {
+	return t.withEnds(t.start.MulDecimal(other), t.stop.MulDecimal(other))
 }
 
+// MulRat multiplies both ends of current taxed money range with given exact fraction.
+// See Money.MulRat
+func (t TaxedMoneyRange) MulRat(other *big.Rat) TaxedMoneyRange {
+	return t.withEnds(t.start.MulRat(other), t.stop.MulRat(other))
+}
+
+// TrueDiv divides both ends of current taxed money range with given other.
+//
+// NOTE: other is converted to decimal, use DivDecimal to avoid binary float errors.
 func (t TaxedMoneyRange) TrueDiv(other float64) TaxedMoneyRange {
-	return TaxedMoneyRange{
-		start: t.start.TrueDiv(other),
-		stop:  t.stop.TrueDiv(other),
+	return t.withEnds(t.start.TrueDiv(other), t.stop.TrueDiv(other))
+}
+
+// DivDecimal divides both ends of current taxed money range with given other.
+// If returned error is not nil, it is ErrDivisorZero
+func (t TaxedMoneyRange) DivDecimal(other decimal.Decimal) (*TaxedMoneyRange, error) {
+	start, err := t.start.divDecimal(other)
+	if err != nil {
+		return nil, err
 	}
+	stop, err := t.stop.divDecimal(other)
+	if err != nil {
+		return nil, err
+	}
+	res := t.withEnds(start, stop)
+	return &res, nil
 }
 
-func (m TaxedMoneyRange) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (*TaxedMoneyRange, error) {
+func (m TaxedMoneyRange) fractionalDiscount(fraction decimal.Decimal, fromGross bool, rounding Rounding) (TaxedMoneyRange, error) {
 	start, err := m.start.fractionalDiscount(fraction, fromGross, rounding)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
 	}
 
 	stop, err := m.stop.fractionalDiscount(fraction, fromGross, rounding)
 	if err != nil {
-		return nil, err
+		return TaxedMoneyRange{}, err
+	}
+
+	return newTaxedMoneyRange(start, stop, m.startBound, m.stopBound)
+}
+
+func (m TaxedMoneyRange) cappedFractionalDiscount(fraction decimal.Decimal, max Money, fromGross bool, rounding Rounding) (TaxedMoneyRange, error) {
+	start, err := m.start.cappedFractionalDiscount(fraction, max, fromGross, rounding)
+	if err != nil {
+		return TaxedMoneyRange{}, err
+	}
+
+	stop, err := m.stop.cappedFractionalDiscount(fraction, max, fromGross, rounding)
+	if err != nil {
+		return TaxedMoneyRange{}, err
 	}
 
-	return NewTaxedMoneyRange(*start, *stop)
+	return newTaxedMoneyRange(start, stop, m.startBound, m.stopBound)
 }
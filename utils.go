@@ -2,18 +2,32 @@ package goprices
 
 import (
 	"strings"
-
-	"golang.org/x/text/currency"
 )
 
 // validateCurrency checks if given `currencyCode` is valid or not.
-// When it is not, returns empty string and ErrUnknownCurrency
+// Only currencies registered in DefaultRegistry are valid, so that constructors accept the same codes
+// as precision lookups (GetCurrencyPrecision, Quantize...). When it is not, returns empty string and an *UnknownCurrencyError
 func validateCurrency(currencyCode string) (string, error) {
-	unit, err := currency.ParseISO(currencyCode)
+	c, ok := DefaultRegistry.Lookup(currencyCode)
+	if !ok {
+		return "", &UnknownCurrencyError{Code: currencyCode}
+	}
+	return c.Code, nil
+}
+
+// checkDeclaredCurrency checks that currency of a decoded range (JSON, proto...) matches its declared currency, when given
+func checkDeclaredCurrency(declared, currency string) error {
+	if declared == "" {
+		return nil
+	}
+	unit, err := validateCurrency(declared)
 	if err != nil {
-		return "", ErrUnknownCurrency
+		return err
+	}
+	if !strings.EqualFold(unit, currency) {
+		return &CurrencyMismatchError{Left: unit, Right: currency}
 	}
-	return unit.String(), nil
+	return nil
 }
 
 // SameKind checks if other's currency is identical to current money currency.
@@ -24,19 +38,31 @@ func (m Money) SameKind(other Money) bool {
 
 // GetCurrencyPrecision returns a number for money rounding.
 //
-// Returned error could be `nil` or `ErrUnknownCurrency`
+// Returned error could be `nil` or an *UnknownCurrencyError
 //
 // E.g:
 //
 //	GetCurrencyPrecision("vnd") => 0, nil
 func GetCurrencyPrecision(currency string) (int, error) {
-	currencyCode, err := validateCurrency(currency)
-	if err != nil {
-		return 0, err
-	}
-	c, ok := currencies[currencyCode]
+	c, ok := DefaultRegistry.Lookup(currency)
 	if !ok {
-		return 0, ErrUnknownCurrency
+		return 0, &UnknownCurrencyError{Code: currency}
 	}
 	return c.Fraction, nil
 }
+
+// ptr returns a pointer to given value, or nil if given error is not nil.
+func ptr[T any](value T, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// must returns given value, it panics if given error is not nil.
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,133 @@
+package goprices
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// amountXML is XML representation of Money, e.g: <Amount currencyID="EUR">12.50</Amount>
+type amountXML struct {
+	Currency string `xml:"currencyID,attr"`
+	Amount   string `xml:",chardata"`
+}
+
+func encodeAmountXML(e *xml.Encoder, start xml.StartElement, currency, amount string) error {
+	start.Attr = append(start.Attr[:len(start.Attr):len(start.Attr)], xml.Attr{Name: xml.Name{Local: "currencyID"}, Value: currency})
+	return e.EncodeElement(amount, start)
+}
+
+// MarshalXML implements xml.Marshaler interface with the currency as an attribute, as UBL invoices do.
+// Amount is formatted with currency precision unless it would lose digits, e.g:
+//
+//	<Amount currencyID="EUR">12.50</Amount>
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeAmountXML(e, start, m.currency, formatAmount(m))
+}
+
+// UnmarshalXML implements xml.Unmarshaler interface. Negative amounts are accepted, e.g: in credit notes.
+//
+// Returned error could be `nil`, an XML syntax error, `ErrInvalidEncoding` or an *UnknownCurrencyError
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v amountXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	amount, err := parseAmount(strings.TrimSpace(v.Amount))
+	if err != nil {
+		return err
+	}
+	res, err := NewSignedMoneyFromDecimal(amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = *res
+	return nil
+}
+
+// XMLAmount marshals Money to XML with a fixed number of decimal places,
+// e.g: when an invoice format requires 2 decimal places whatever the currency.
+// The zero value of Places formats the amount with currency precision. It is unmarshalled like Money.
+type XMLAmount struct {
+	Money
+	// Places is the number of decimal places. NOTE: if Places is nil or negative, currency precision (Fraction) will be used
+	Places *int
+	// Rounding is used when the amount has more decimal places than Places
+	Rounding Rounding
+}
+
+// xmlPlaces returns the number of decimal places to format amounts of given currency with
+func xmlPlaces(places *int, currency string) (int, error) {
+	if places != nil && *places >= 0 {
+		return *places, nil
+	}
+	return GetCurrencyPrecision(currency)
+}
+
+// MarshalXML implements xml.Marshaler interface.
+//
+// Returned error could be `nil`, `ErrInvalidRounding`, an *UnknownCurrencyError or an XML error
+func (a XMLAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	places, err := xmlPlaces(a.Places, a.currency)
+	if err != nil {
+		return err
+	}
+	money, err := a.quantize(a.Rounding, places)
+	if err != nil {
+		return err
+	}
+	return encodeAmountXML(e, start, money.currency, money.amount.StringFixed(int32(places)))
+}
+
+// taxedMoneyXML is XML representation of TaxedMoney, using UBL element names
+type taxedMoneyXML struct {
+	Net   Money `xml:"TaxExclusiveAmount"`
+	Gross Money `xml:"TaxInclusiveAmount"`
+}
+
+// MarshalXML implements xml.Marshaler interface, net and gross are UBL TaxExclusiveAmount
+// and TaxInclusiveAmount elements, e.g:
+//
+//	<Total>
+//		<TaxExclusiveAmount currencyID="EUR">10.00</TaxExclusiveAmount>
+//		<TaxInclusiveAmount currencyID="EUR">12.30</TaxInclusiveAmount>
+//	</Total>
+func (t TaxedMoney) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(taxedMoneyXML{t.net, t.gross}, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler interface.
+//
+// Returned error could be `nil`, an XML syntax error, `ErrInvalidEncoding`, an *UnknownCurrencyError or a *CurrencyMismatchError
+func (t *TaxedMoney) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v taxedMoneyXML
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	res, err := newTaxedMoney(v.Net, v.Gross)
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
+// XMLTaxedAmount marshals TaxedMoney to XML with a fixed number of decimal places. See XMLAmount
+type XMLTaxedAmount struct {
+	TaxedMoney
+	// Places is the number of decimal places. NOTE: if Places is nil or negative, currency precision (Fraction) will be used
+	Places *int
+	// Rounding is used when net or gross has more decimal places than Places
+	Rounding Rounding
+}
+
+// MarshalXML implements xml.Marshaler interface. See XMLAmount.MarshalXML
+func (a XMLTaxedAmount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	v := struct {
+		Net   XMLAmount `xml:"TaxExclusiveAmount"`
+		Gross XMLAmount `xml:"TaxInclusiveAmount"`
+	}{
+		XMLAmount{a.net, a.Places, a.Rounding},
+		XMLAmount{a.gross, a.Places, a.Rounding},
+	}
+	return e.EncodeElement(v, start)
+}
@@ -0,0 +1,148 @@
+package goprices
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+func TestMoneyXML(t *testing.T) {
+	type line struct {
+		XMLName xml.Name `xml:"InvoiceLine"`
+		Amount  Money    `xml:"LineExtensionAmount"`
+	}
+	value := line{Amount: *must(NewMoney(12.5, EUR))}
+	data, err := xml.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<InvoiceLine><LineExtensionAmount currencyID="EUR">12.50</LineExtensionAmount></InvoiceLine>`
+	if string(data) != expected {
+		t.Fatalf("expected: %s, got: %s", expected, data)
+	}
+
+	var decoded line
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Amount.Equal(value.Amount) {
+		t.Fatalf("expected: %s, got: %s", value.Amount, decoded.Amount)
+	}
+
+	// UBL elements are namespaced, e.g: cbc:PayableAmount
+	ubl := `<Invoice xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">
+		<cbc:PayableAmount currencyID="usd"> -3.5 </cbc:PayableAmount>
+	</Invoice>`
+	var invoice struct {
+		Payable Money `xml:"PayableAmount"`
+	}
+	if err := xml.Unmarshal([]byte(ubl), &invoice); err != nil {
+		t.Fatal(err)
+	}
+	if !invoice.Payable.Equal(*must(NewSignedMoney(-3.5, USD))) {
+		t.Fatalf("unexpected money: %s", invoice.Payable)
+	}
+
+	type errUnit struct {
+		data string
+		err  error
+	}
+	for index, unit := range []errUnit{
+		{`<Amount currencyID="EUR">1,5</Amount>`, ErrInvalidEncoding},
+		{`<Amount currencyID="ABC">1</Amount>`, ErrUnknownCurrency},
+		{`<Amount>1</Amount>`, ErrUnknownCurrency},
+	} {
+		var money Money
+		if err := xml.Unmarshal([]byte(unit.data), &money); !errors.Is(err, unit.err) {
+			t.Fatalf("Error at index: %d, expected: %v, got: %v", index, unit.err, err)
+		}
+	}
+}
+
+func TestXMLAmount(t *testing.T) {
+	places := func(n int) *int { return &n }
+
+	type testUnit struct {
+		amount   XMLAmount
+		expected string
+	}
+	for index, unit := range []testUnit{
+		{XMLAmount{Money: *must(NewMoney(12.5, EUR))}, `<XMLAmount currencyID="EUR">12.50</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(12.345, EUR)), nil, Up}, `<XMLAmount currencyID="EUR">12.35</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(12.345, EUR)), places(-1), Down}, `<XMLAmount currencyID="EUR">12.34</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(7, BHD)), nil, Up}, `<XMLAmount currencyID="BHD">7.000</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(7, BHD)), places(2), Up}, `<XMLAmount currencyID="BHD">7.00</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(1.5, JPY)), places(0), Floor}, `<XMLAmount currencyID="JPY">1</XMLAmount>`},
+		{XMLAmount{*must(NewMoney(12.5, EUR)), places(0), Floor}, `<XMLAmount currencyID="EUR">12</XMLAmount>`},
+	} {
+		data, err := xml.Marshal(unit.amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != unit.expected {
+			t.Fatalf("Error at index: %d, expected: %s, got: %s", index, unit.expected, data)
+		}
+	}
+
+	if _, err := xml.Marshal(XMLAmount{*must(NewMoney(1, EUR)), places(2), Rounding(9)}); !errors.Is(err, ErrInvalidRounding) {
+		t.Fatalf("expected ErrInvalidRounding, got: %v", err)
+	}
+}
+
+func TestTaxedMoneyXML(t *testing.T) {
+	type total struct {
+		XMLName xml.Name   `xml:"LegalMonetaryTotal"`
+		Total   TaxedMoney `xml:"Total"`
+	}
+	value := total{Total: *must(NewTaxedMoneyFromFloats(10, 12.3, EUR))}
+	data, err := xml.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<LegalMonetaryTotal><Total>` +
+		`<TaxExclusiveAmount currencyID="EUR">10.00</TaxExclusiveAmount>` +
+		`<TaxInclusiveAmount currencyID="EUR">12.30</TaxInclusiveAmount>` +
+		`</Total></LegalMonetaryTotal>`
+	if string(data) != expected {
+		t.Fatalf("expected: %s, got: %s", expected, data)
+	}
+
+	var decoded total
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Total.Equal(value.Total) {
+		t.Fatalf("expected: %s, got: %s", value.Total, decoded.Total)
+	}
+
+	mismatch := `<Total><TaxExclusiveAmount currencyID="EUR">1</TaxExclusiveAmount>` +
+		`<TaxInclusiveAmount currencyID="USD">1</TaxInclusiveAmount></Total>`
+	if err := xml.Unmarshal([]byte(mismatch), &decoded.Total); !errors.Is(err, ErrNotSameCurrency) {
+		t.Fatalf("expected ErrNotSameCurrency, got: %v", err)
+	}
+
+	two := 2
+	data, err = xml.Marshal(XMLTaxedAmount{*must(NewTaxedMoneyFromFloats(10, 12.345, EUR)), &two, Down})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `<XMLTaxedAmount>` +
+		`<TaxExclusiveAmount currencyID="EUR">10.00</TaxExclusiveAmount>` +
+		`<TaxInclusiveAmount currencyID="EUR">12.34</TaxInclusiveAmount>` +
+		`</XMLTaxedAmount>`
+	if string(data) != expected {
+		t.Fatalf("expected: %s, got: %s", expected, data)
+	}
+
+	data, err = xml.Marshal(XMLTaxedAmount{TaxedMoney: *must(NewTaxedMoneyFromFloats(10, 12.3, EUR))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `<XMLTaxedAmount>` +
+		`<TaxExclusiveAmount currencyID="EUR">10.00</TaxExclusiveAmount>` +
+		`<TaxInclusiveAmount currencyID="EUR">12.30</TaxInclusiveAmount>` +
+		`</XMLTaxedAmount>`
+	if string(data) != expected {
+		t.Fatalf("expected: %s, got: %s", expected, data)
+	}
+}